@@ -0,0 +1,107 @@
+package sandwich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sandwichcloud/deli-cli/api/client"
+	"github.com/sandwichcloud/deli-cli/api/client/flavor"
+	"github.com/sandwichcloud/deli-cli/api/client/image"
+	"github.com/sandwichcloud/deli-cli/api/client/instance"
+	"github.com/sandwichcloud/deli-cli/api/client/keypair"
+	"github.com/sandwichcloud/deli-cli/api/client/network"
+	"github.com/sandwichcloud/deli-cli/api/client/permission"
+	"github.com/sandwichcloud/deli-cli/api/client/policy"
+	"github.com/sandwichcloud/deli-cli/api/client/project"
+	"github.com/sandwichcloud/deli-cli/api/client/region"
+	"github.com/sandwichcloud/deli-cli/api/client/role"
+	"github.com/sandwichcloud/deli-cli/api/client/serviceAccount"
+	"github.com/sandwichcloud/deli-cli/api/client/volume"
+	"github.com/sandwichcloud/deli-cli/api/client/zone"
+	"golang.org/x/oauth2"
+)
+
+// sandwichClient builds the deli-cli API clients like client.SandwichClient does,
+// but on top of an http.Client owned by the provider so requests go through the
+// logging and rate limiting transports.
+type sandwichClient struct {
+	APIServer  *string
+	HttpClient *http.Client
+}
+
+func newSandwichClient(apiServer *string, transport http.RoundTripper, token *oauth2.Token) *sandwichClient {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	return &sandwichClient{
+		APIServer:  apiServer,
+		HttpClient: oauth2.NewClient(ctx, oauth2.StaticTokenSource(token)),
+	}
+}
+
+func (c *sandwichClient) Project() client.ProjectClientInterface {
+	return &project.ProjectClient{APIServer: c.APIServer, HttpClient: c.HttpClient}
+}
+
+func (c *sandwichClient) Region() client.RegionClientInterface {
+	return &region.RegionClient{APIServer: c.APIServer, HttpClient: c.HttpClient}
+}
+
+func (c *sandwichClient) Zone() zoneClientInterface {
+	return zoneClient{&zone.ZoneClient{APIServer: c.APIServer, HttpClient: c.HttpClient}}
+}
+
+func (c *sandwichClient) Volume(projectName string) client.VolumeClientInterface {
+	return volumeClient{&volume.VolumeClient{APIServer: c.APIServer, HttpClient: c.HttpClient, ProjectName: projectName}}
+}
+
+func (c *sandwichClient) Image(projectName string) imageClientInterface {
+	return imageClient{&image.ImageClient{APIServer: c.APIServer, HttpClient: c.HttpClient, ProjectName: projectName}}
+}
+
+func (c *sandwichClient) Network() client.NetworkClientInterface {
+	return &network.NetworkClient{APIServer: c.APIServer, HttpClient: c.HttpClient}
+}
+
+func (c *sandwichClient) NetworkPort(projectName string) client.NetworkPortClientInterface {
+	return &network.NetworkPortClient{APIServer: c.APIServer, HttpClient: c.HttpClient, ProjectName: projectName}
+}
+
+func (c *sandwichClient) Keypair(projectName string) client.KeypairClientInterface {
+	return &keypair.KeypairClient{APIServer: c.APIServer, HttpClient: c.HttpClient, ProjectName: projectName}
+}
+
+func (c *sandwichClient) Flavor() client.FlavorClientInterface {
+	return &flavor.FlavorClient{APIServer: c.APIServer, HttpClient: c.HttpClient}
+}
+
+func (c *sandwichClient) Instance(projectName string) client.InstanceClientInterface {
+	return &instance.InstanceClient{APIServer: c.APIServer, HttpClient: c.HttpClient, ProjectName: projectName}
+}
+
+func (c *sandwichClient) Permission() client.PermissionClientInterface {
+	return &permission.PermissionClient{APIServer: c.APIServer, HttpClient: c.HttpClient}
+}
+
+func (c *sandwichClient) SystemRole() client.RoleClientInterface {
+	return &role.RoleClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: "system/roles"}
+}
+
+func (c *sandwichClient) ProjectRole(projectName string) client.RoleClientInterface {
+	return &role.RoleClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: fmt.Sprintf("projects/%s/roles", projectName)}
+}
+
+func (c *sandwichClient) SystemServiceAccount() client.ServiceAccountClientInterface {
+	return &serviceAccount.ServiceAccountClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: "system/service-accounts"}
+}
+
+func (c *sandwichClient) ProjectServiceAccount(projectName string) client.ServiceAccountClientInterface {
+	return &serviceAccount.ServiceAccountClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: fmt.Sprintf("projects/%s/service-accounts", projectName)}
+}
+
+func (c *sandwichClient) SystemPolicy() client.PolicyClientInterface {
+	return &policy.PolicyClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: "system/policy"}
+}
+
+func (c *sandwichClient) ProjectPolicy(projectName string) client.PolicyClientInterface {
+	return &policy.PolicyClient{APIServer: c.APIServer, HttpClient: c.HttpClient, Type: fmt.Sprintf("projects/%s/policy", projectName)}
+}
@@ -0,0 +1,230 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client"
+	"github.com/sandwichcloud/deli-cli/api/client/image"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// imageClientInterface adds the image calls deli-cli 0.0.32 doesn't have yet
+type imageClientInterface interface {
+	client.ImageClientInterface
+	Upload(regionName, fileName string, contents io.Reader, size int64, timeout time.Duration) error
+	ActionSetVisibility(name, visibility string) error
+	ListMembers(name string) (*imageMemberList, error)
+	AddMember(name, projectName string) error
+	RemoveMember(name, projectName string) error
+}
+
+type imageMember struct {
+	ProjectName string `json:"project_name"`
+}
+
+type imageMemberList struct {
+	Members []imageMember `json:"members"`
+}
+
+type imageClient struct {
+	*image.ImageClient
+}
+
+// Upload streams an image file into the region's image datastore so it can be registered with Create.
+// Uploads can take far longer than other calls so they get their own timeout.
+func (client imageClient) Upload(regionName, fileName string, contents io.Reader, size int64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	parameters := url.Values{}
+	parameters.Add("region_name", regionName)
+	parameters.Add("file_name", fileName)
+
+	Url, err := url.Parse(*client.APIServer + "/compute/v1/projects/" + client.ProjectName + "/images/upload")
+	if err != nil {
+		return err
+	}
+	Url.RawQuery = parameters.Encode()
+
+	req, err := http.NewRequest(http.MethodPut, Url.String(), contents)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Add("Content-Type", "application/octet-stream")
+	response, err := ctxhttp.Do(ctx, client.HttpClient, req)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return api.ErrTimedOut
+		}
+		return err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return err
+		}
+		return apiError
+	}
+
+	return nil
+}
+
+func (client imageClient) ActionSetVisibility(name, visibility string) error {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+
+	type visibilityBody struct {
+		Visibility string `json:"visibility"`
+	}
+
+	body := visibilityBody{Visibility: visibility}
+	jsonBody, _ := json.Marshal(body)
+
+	req, err := http.NewRequest(http.MethodPut, *client.APIServer+fmt.Sprintf("/compute/v1/projects/%s/images/%s/action/visibility", client.ProjectName, name), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	response, err := ctxhttp.Do(ctx, client.HttpClient, req)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return api.ErrTimedOut
+		}
+		return err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return err
+		}
+		return apiError
+	}
+	return nil
+}
+
+func (client imageClient) ListMembers(name string) (*imageMemberList, error) {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+
+	response, err := ctxhttp.Get(ctx, client.HttpClient, *client.APIServer+"/compute/v1/projects/"+client.ProjectName+"/images/"+name+"/members")
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, api.ErrTimedOut
+		}
+		return nil, err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return nil, err
+		}
+		return nil, apiError
+	}
+
+	members := &imageMemberList{}
+	json.Unmarshal(responseData, members)
+	return members, nil
+}
+
+func (client imageClient) AddMember(name, projectName string) error {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+
+	type memberBody struct {
+		ProjectName string `json:"project_name"`
+	}
+
+	body := memberBody{ProjectName: projectName}
+	jsonBody, _ := json.Marshal(body)
+
+	response, err := ctxhttp.Post(ctx, client.HttpClient, *client.APIServer+"/compute/v1/projects/"+client.ProjectName+"/images/"+name+"/members", "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return api.ErrTimedOut
+		}
+		return err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return err
+		}
+		return apiError
+	}
+	return nil
+}
+
+func (client imageClient) RemoveMember(name, projectName string) error {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+	Url, err := url.Parse(*client.APIServer + "/compute/v1/projects/" + client.ProjectName + "/images/" + name + "/members/" + projectName)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", Url.String(), nil)
+	if err != nil {
+		return err
+	}
+	response, err := ctxhttp.Do(ctx, client.HttpClient, req)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return api.ErrTimedOut
+		}
+		return err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return err
+		}
+		return apiError
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client/volume"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// volumeClient fixes the volume calls deli-cli 0.0.32 gets wrong, its
+// ActionClone posts to the attach action instead of the clone action.
+type volumeClient struct {
+	*volume.VolumeClient
+}
+
+func (volumeClient volumeClient) ActionClone(name, newName string) (*api.Volume, error) {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+
+	type cloneBody struct {
+		Name string `json:"name"`
+	}
+
+	body := cloneBody{Name: newName}
+	jsonBody, _ := json.Marshal(body)
+
+	response, err := ctxhttp.Post(ctx, volumeClient.HttpClient, *volumeClient.APIServer+fmt.Sprintf("/compute/v1/projects/%s/volumes/%s/action/clone", volumeClient.ProjectName, name), "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, api.ErrTimedOut
+		}
+		return nil, err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return nil, err
+		}
+		return nil, apiError
+	}
+
+	volume := &api.Volume{}
+	json.Unmarshal(responseData, volume)
+	return volume, nil
+}
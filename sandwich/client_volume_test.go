@@ -0,0 +1,71 @@
+package sandwich
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client/volume"
+)
+
+func TestVolumeClientActionClone(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantErr    bool
+	}{
+		{
+			name:       "cloned",
+			statusCode: http.StatusOK,
+			response:   `{"name": "db-copy", "zone_name": "zone-a", "size": 20, "state": "ToCreate"}`,
+		},
+		{
+			name:       "name taken",
+			statusCode: http.StatusConflict,
+			response:   `{"status": "Conflict", "message": "a volume named db-copy already exists"}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var method, path string
+			var body map[string]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				data, _ := ioutil.ReadAll(r.Body)
+				json.Unmarshal(data, &body)
+				w.WriteHeader(tc.statusCode)
+				w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			client := volumeClient{&volume.VolumeClient{APIServer: &server.URL, HttpClient: server.Client(), ProjectName: "project-a"}}
+			result, err := client.ActionClone("db", "db-copy")
+
+			if method != http.MethodPost || path != "/compute/v1/projects/project-a/volumes/db/action/clone" {
+				t.Fatalf("expected POST /compute/v1/projects/project-a/volumes/db/action/clone, got %s %s", method, path)
+			}
+			if body["name"] != "db-copy" {
+				t.Fatalf("unexpected request body %v", body)
+			}
+
+			if tc.wantErr {
+				if _, ok := err.(api.APIError); !ok {
+					t.Fatalf("expected an APIError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Name != "db-copy" || result.ZoneName != "zone-a" {
+				t.Fatalf("unexpected volume %+v", result)
+			}
+		})
+	}
+}
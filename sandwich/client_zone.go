@@ -0,0 +1,71 @@
+package sandwich
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client"
+	"github.com/sandwichcloud/deli-cli/api/client/zone"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+// zoneClientInterface adds the zone calls deli-cli 0.0.32 doesn't have yet
+type zoneClientInterface interface {
+	client.ZoneClientInterface
+	Update(name string, coreProvisionPercent, ramProvisionPercent int) (*api.Zone, error)
+}
+
+type zoneClient struct {
+	*zone.ZoneClient
+}
+
+func (zoneClient zoneClient) Update(name string, coreProvisionPercent, ramProvisionPercent int) (*api.Zone, error) {
+	ctx, cancel := api.CreateTimeoutContext()
+	defer cancel()
+
+	type updateBody struct {
+		CoreProvisionPercent int `json:"core_provision_percent"`
+		RamProvisionPercent  int `json:"ram_provision_percent"`
+	}
+
+	body := updateBody{
+		CoreProvisionPercent: coreProvisionPercent,
+		RamProvisionPercent:  ramProvisionPercent,
+	}
+	jsonBody, _ := json.Marshal(body)
+
+	req, err := http.NewRequest(http.MethodPatch, *zoneClient.APIServer+"/location/v1/zones/"+name, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	response, err := ctxhttp.Do(ctx, zoneClient.HttpClient, req)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, api.ErrTimedOut
+		}
+		return nil, err
+	}
+
+	responseData, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		apiError, err := api.ParseErrors(response.StatusCode, responseData)
+		if err != nil {
+			return nil, err
+		}
+		return nil, apiError
+	}
+
+	zone := &api.Zone{}
+	json.Unmarshal(responseData, zone)
+	return zone, nil
+}
@@ -0,0 +1,71 @@
+package sandwich
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client/zone"
+)
+
+func TestZoneClientUpdate(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		response   string
+		wantErr    bool
+	}{
+		{
+			name:       "updated",
+			statusCode: http.StatusOK,
+			response:   `{"name": "zone-a", "core_provision_percent": 800, "ram_provision_percent": 200}`,
+		},
+		{
+			name:       "rejected",
+			statusCode: http.StatusBadRequest,
+			response:   `{"status": "Bad Request", "message": "core_provision_percent is too low"}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var method, path string
+			var body map[string]int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				data, _ := ioutil.ReadAll(r.Body)
+				json.Unmarshal(data, &body)
+				w.WriteHeader(tc.statusCode)
+				w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			client := zoneClient{&zone.ZoneClient{APIServer: &server.URL, HttpClient: server.Client()}}
+			result, err := client.Update("zone-a", 800, 200)
+
+			if method != http.MethodPatch || path != "/location/v1/zones/zone-a" {
+				t.Fatalf("expected PATCH /location/v1/zones/zone-a, got %s %s", method, path)
+			}
+			if body["core_provision_percent"] != 800 || body["ram_provision_percent"] != 200 {
+				t.Fatalf("unexpected request body %v", body)
+			}
+
+			if tc.wantErr {
+				if _, ok := err.(api.APIError); !ok {
+					t.Fatalf("expected an APIError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.CoreProvisionPercent != 800 || result.RamProvisionPercent != 200 {
+				t.Fatalf("unexpected zone %+v", result)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package sandwich
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/sandwichcloud/deli-cli/api"
-	"github.com/sandwichcloud/deli-cli/api/client"
 	"golang.org/x/oauth2"
 )
 
@@ -12,14 +12,26 @@ type Config struct {
 	APIServer   string
 	Token       string
 	ProjectName string
+	LogFile     string
+	DefaultTags map[string]string
 
-	SandwichClient client.ClientInterface
+	MaxRequestsPerSecond        int
+	MaxConcurrentCreates        int
+	MaxConcurrentCreatesPerZone int
+	PolicyConflictRetries       int
+
+	SandwichClient *sandwichClient
+	createLimiter  *createLimiter
+	iamClaims      *iamClaimRegistry
+
+	permissionCatalog permissionCatalog
 }
 
 func (c *Config) LoadAndValidate() error {
 
-	c.SandwichClient = &client.SandwichClient{
-		APIServer: &c.APIServer,
+	transport, err := newLoggingTransport(http.DefaultTransport, c.LogFile)
+	if err != nil {
+		return err
 	}
 
 	token := &oauth2.Token{
@@ -27,7 +39,10 @@ func (c *Config) LoadAndValidate() error {
 		TokenType:   "Bearer",
 	}
 
-	c.SandwichClient.SetToken(token)
+	c.SandwichClient = newSandwichClient(&c.APIServer, newRateLimitedTransport(transport, c.MaxRequestsPerSecond), token)
+	c.createLimiter = newCreateLimiter(c.MaxConcurrentCreates, c.MaxConcurrentCreatesPerZone)
+	c.iamClaims = newIAMClaimRegistry()
+
 	if c.ProjectName != "" {
 		_, err := c.SandwichClient.Project().Get(c.ProjectName)
 		if err != nil {
@@ -0,0 +1,120 @@
+package sandwich
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkPorts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkPortsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPortsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	networkName := d.Get("network_name").(string)
+	state := d.Get("state").(string)
+
+	networkPorts, err := listAllNetworkPorts(config.SandwichClient.NetworkPort(projectName))
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0)
+	ipAddresses := make([]string, 0)
+	ports := make([]map[string]interface{}, 0)
+	for _, networkPort := range networkPorts {
+		if networkName != "" && networkPort.NetworkName != networkName {
+			continue
+		}
+		if state != "" && networkPort.State != state {
+			continue
+		}
+
+		ids = append(ids, networkPort.ID.String())
+		ipAddresses = append(ipAddresses, networkPort.IPAddress.String())
+		ports = append(ports, map[string]interface{}{
+			"id":           networkPort.ID.String(),
+			"network_name": networkPort.NetworkName,
+			"ip_address":   networkPort.IPAddress.String(),
+			"state":        networkPort.State,
+			"created_at":   networkPort.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s/%s/%s", projectName, networkName, state))))
+	d.Set("project_name", projectName)
+	d.Set("ids", ids)
+	d.Set("ip_addresses", ipAddresses)
+	d.Set("ports", ports)
+
+	return nil
+}
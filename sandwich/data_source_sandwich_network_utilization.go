@@ -0,0 +1,150 @@
+package sandwich
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func dataSourceNetworkUtilization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkUtilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"pool_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_addresses": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"allocated_addresses": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_addresses": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"allocated_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"skipped_projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkUtilizationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	networkName := d.Get("name").(string)
+	network, err := config.SandwichClient.Network().Get(networkName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return fmt.Errorf("Could not find a network with the name of %s", networkName)
+			}
+		}
+		return err
+	}
+
+	poolStart := ipv4ToUint32(network.PoolStart)
+	poolEnd := ipv4ToUint32(network.PoolEnd)
+
+	projects, err := listAllProjects(config.SandwichClient.Project())
+	if err != nil {
+		return err
+	}
+
+	// Ports can only be listed per project, so projects the token can't read
+	// are skipped and reported rather than failing the whole lookup
+	allocated := map[uint32]bool{}
+	skippedProjects := make([]string, 0)
+	for _, project := range projects {
+		networkPorts, err := listAllNetworkPorts(config.SandwichClient.NetworkPort(project.Name))
+		if err != nil {
+			if apiError, ok := err.(api.APIError); ok && apiError.StatusCode == http.StatusForbidden {
+				log.Printf("[WARN] Not allowed to list network ports in project %s, skipping it", project.Name)
+				skippedProjects = append(skippedProjects, project.Name)
+				continue
+			}
+			return err
+		}
+
+		for _, networkPort := range networkPorts {
+			if networkPort.NetworkName != network.Name || networkPort.IPAddress.To4() == nil {
+				continue
+			}
+			ip := ipv4ToUint32(networkPort.IPAddress)
+			if ip >= poolStart && ip <= poolEnd {
+				allocated[ip] = true
+			}
+		}
+	}
+
+	allocatedIPs := make([]uint32, 0, len(allocated))
+	for ip := range allocated {
+		allocatedIPs = append(allocatedIPs, ip)
+	}
+	sort.Slice(allocatedIPs, func(i, j int) bool { return allocatedIPs[i] < allocatedIPs[j] })
+
+	allocatedIPStrings := make([]string, 0, len(allocatedIPs))
+	for _, ip := range allocatedIPs {
+		allocatedIPStrings = append(allocatedIPStrings, uint32ToIPv4(ip).String())
+	}
+
+	total := 0
+	if poolEnd >= poolStart {
+		total = int(poolEnd-poolStart) + 1
+	}
+
+	d.SetId(network.Name)
+	d.Set("pool_start", network.PoolStart.String())
+	d.Set("pool_end", network.PoolEnd.String())
+	d.Set("total_addresses", total)
+	d.Set("allocated_addresses", len(allocatedIPs))
+	d.Set("free_addresses", total-len(allocatedIPs))
+	d.Set("allocated_ips", allocatedIPStrings)
+	d.Set("skipped_projects", skippedProjects)
+
+	return nil
+}
+
+func ipv4ToUint32(ip net.IP) uint32 {
+	ip = ip.To4()
+	if ip == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(ip)
+}
+
+func uint32ToIPv4(value uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, value)
+	return ip
+}
@@ -0,0 +1,139 @@
+package sandwich
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func dataSourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+			"name_glob": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"description_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	permissions, err := config.permissionCatalog.Load(config.SandwichClient.Permission())
+	if err != nil {
+		return err
+	}
+
+	var tags []string
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+	sort.Strings(tags)
+	nameGlob := d.Get("name_glob").(string)
+	descriptionContains := d.Get("description_contains").(string)
+
+	if nameGlob != "" {
+		if _, err := path.Match(nameGlob, ""); err != nil {
+			return fmt.Errorf("name_glob: invalid pattern %q: %s", nameGlob, err)
+		}
+	}
+
+	var names []string
+	var matched []map[string]interface{}
+	for _, permission := range permissions {
+		if !permissionMatches(permission, tags, nameGlob, descriptionContains) {
+			continue
+		}
+		names = append(names, permission.Name)
+		matched = append(matched, map[string]interface{}{
+			"name":        permission.Name,
+			"description": permission.Description,
+			"tags":        permission.Tags,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%v/%s/%s", tags, nameGlob, descriptionContains))))
+	d.Set("names", names)
+	d.Set("permissions", matched)
+
+	return nil
+}
+
+func permissionMatches(permission api.Permissions, tags []string, nameGlob, descriptionContains string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, permissionTag := range permission.Tags {
+			if permissionTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if nameGlob != "" {
+		if ok, _ := path.Match(nameGlob, permission.Name); !ok {
+			return false
+		}
+	}
+
+	if descriptionContains != "" && !strings.Contains(strings.ToLower(permission.Description), strings.ToLower(descriptionContains)) {
+		return false
+	}
+
+	return true
+}
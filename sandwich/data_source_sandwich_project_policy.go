@@ -0,0 +1,64 @@
+package sandwich
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceProjectPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"resource_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"binding": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	policyClient := config.SandwichClient.ProjectPolicy(projectName)
+
+	policy, err := policyClient.Get()
+	if err != nil {
+		return err
+	}
+
+	d.SetId(projectName)
+	d.Set("project_name", projectName)
+	d.Set("resource_version", policy.ResourceVersion)
+	d.Set("binding", flattenIAMBindings(policy.Bindings))
+
+	return nil
+}
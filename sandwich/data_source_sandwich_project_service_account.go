@@ -0,0 +1,75 @@
+package sandwich
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func dataSourceProjectServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectServiceAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProjectServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(projectName)
+
+	serviceAccountName := d.Get("name").(string)
+	serviceAccount, err := serviceAccountClient.Get(serviceAccountName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return fmt.Errorf("Could not find a service account with the name of %s in project %s", serviceAccountName, projectName)
+			}
+		}
+		return err
+	}
+
+	d.SetId(projectName + "/" + serviceAccount.Name)
+	d.Set("project_name", projectName)
+	d.Set("email", serviceAccount.Email)
+	d.Set("state", serviceAccount.State)
+	d.Set("keys", serviceAccount.Keys)
+	d.Set("created_at", serviceAccount.CreatedAt.Format(time.RFC3339))
+
+	return nil
+}
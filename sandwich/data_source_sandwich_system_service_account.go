@@ -0,0 +1,65 @@
+package sandwich
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func dataSourceSystemServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSystemServiceAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSystemServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceAccountClient := config.SandwichClient.SystemServiceAccount()
+
+	serviceAccountName := d.Get("name").(string)
+	serviceAccount, err := serviceAccountClient.Get(serviceAccountName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return fmt.Errorf("Could not find a system service account with the name of %s", serviceAccountName)
+			}
+		}
+		return err
+	}
+
+	d.SetId(serviceAccount.Name)
+	d.Set("email", serviceAccount.Email)
+	d.Set("state", serviceAccount.State)
+	d.Set("keys", serviceAccount.Keys)
+	d.Set("created_at", serviceAccount.CreatedAt.Format(time.RFC3339))
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package sandwich
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func dataSourceZoneCapacity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceZoneCapacityRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// The API doesn't know the size of the cluster behind a zone, so the
+			// limits can only be worked out when it is given here
+			"physical_cores": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"physical_ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"core_provision_percent": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram_provision_percent": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"schedulable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_vcpu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_disk": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vcpu_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_vcpu": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"core_provision_used_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"ram_provision_used_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"skipped_projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneCapacityRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	zoneName := d.Get("name").(string)
+	zone, err := config.SandwichClient.Zone().Get(zoneName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return fmt.Errorf("Could not find a zone with the name of %s", zoneName)
+			}
+		}
+		return err
+	}
+
+	projects, err := listAllProjects(config.SandwichClient.Project())
+	if err != nil {
+		return err
+	}
+
+	usage := &zoneUsage{}
+	skippedProjects := make([]string, 0)
+	for _, project := range projects {
+		err := usage.addProject(config, project.Name, zone.Name)
+		if err != nil {
+			if apiError, ok := err.(api.APIError); ok && apiError.StatusCode == http.StatusForbidden {
+				log.Printf("[WARN] Not allowed to list instances or volumes in project %s, skipping it", project.Name)
+				skippedProjects = append(skippedProjects, project.Name)
+				continue
+			}
+			return err
+		}
+	}
+
+	d.SetId(zone.Name)
+	d.Set("core_provision_percent", zone.CoreProvisionPercent)
+	d.Set("ram_provision_percent", zone.RamProvisionPercent)
+	d.Set("schedulable", zone.Schedulable)
+	d.Set("instance_count", usage.instances)
+	d.Set("volume_count", usage.volumes)
+	d.Set("used_vcpu", usage.vcpu)
+	d.Set("used_ram", usage.ram)
+	d.Set("used_disk", usage.disk)
+	d.Set("skipped_projects", skippedProjects)
+
+	// The limits are only known when the physical size of the zone is given
+	if physicalCores := d.Get("physical_cores").(int); physicalCores > 0 {
+		vcpuLimit := physicalCores * zone.CoreProvisionPercent / 100
+		d.Set("vcpu_limit", vcpuLimit)
+		d.Set("free_vcpu", vcpuLimit-usage.vcpu)
+		d.Set("core_provision_used_percent", usedPercent(usage.vcpu, vcpuLimit))
+	}
+	if physicalRam := d.Get("physical_ram").(int); physicalRam > 0 {
+		ramLimit := physicalRam * zone.RamProvisionPercent / 100
+		d.Set("ram_limit", ramLimit)
+		d.Set("free_ram", ramLimit-usage.ram)
+		d.Set("ram_provision_used_percent", usedPercent(usage.ram, ramLimit))
+	}
+
+	return nil
+}
+
+type zoneUsage struct {
+	instances int
+	volumes   int
+	vcpu      int
+	ram       int
+	disk      int
+}
+
+func (u *zoneUsage) addProject(config *Config, projectName, zoneName string) error {
+	instances, err := listAllInstances(config.SandwichClient.Instance(projectName))
+	if err != nil {
+		return err
+	}
+	volumes, err := listAllVolumes(config.SandwichClient.Volume(projectName))
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instances {
+		if instance.ZoneName != zoneName {
+			continue
+		}
+		u.instances++
+		u.vcpu += instance.VCPUS
+		u.ram += instance.Ram
+		u.disk += instance.Disk
+	}
+	for _, volume := range volumes {
+		if volume.ZoneName != zoneName {
+			continue
+		}
+		u.volumes++
+		u.disk += volume.Size
+	}
+
+	return nil
+}
+
+func usedPercent(used, limit int) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return float64(used) * 100 / float64(limit)
+}
@@ -0,0 +1,113 @@
+package sandwich
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+// zoneWorkloads lists the instances and volumes placed in any of the zones,
+// across every project the token can see.
+func zoneWorkloads(config *Config, zoneNames map[string]bool) ([]string, error) {
+	projects, err := listAllProjects(config.SandwichClient.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := make([]string, 0)
+	for _, project := range projects {
+		instances, err := listAllInstances(config.SandwichClient.Instance(project.Name))
+		if err == nil {
+			for _, instance := range instances {
+				if zoneNames[instance.ZoneName] {
+					workloads = append(workloads, fmt.Sprintf("instance %s/%s in zone %s", project.Name, instance.Name, instance.ZoneName))
+				}
+			}
+
+			var volumes []api.Volume
+			volumes, err = listAllVolumes(config.SandwichClient.Volume(project.Name))
+			if err == nil {
+				for _, volume := range volumes {
+					if zoneNames[volume.ZoneName] {
+						workloads = append(workloads, fmt.Sprintf("volume %s/%s in zone %s", project.Name, volume.Name, volume.ZoneName))
+					}
+				}
+			}
+		}
+
+		if err != nil {
+			if apiError, ok := err.(api.APIError); ok && apiError.StatusCode == http.StatusForbidden {
+				log.Printf("[WARN] Not allowed to list instances or volumes in project %s, its workloads are not checked", project.Name)
+				continue
+			}
+			return nil, err
+		}
+	}
+
+	sort.Strings(workloads)
+	return workloads, nil
+}
+
+// drainZones waits for every instance and volume to leave the zones, failing
+// with the list of what is still there once the timeout runs out.
+func drainZones(config *Config, zoneNames map[string]bool, timeout time.Duration) error {
+	var workloads []string
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Draining"},
+		Target:  []string{"Drained"},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			workloads, err = zoneWorkloads(config, zoneNames)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(workloads) > 0 {
+				log.Printf("[DEBUG] Waiting for %d workloads to leave zones %v", len(workloads), zoneNamesList(zoneNames))
+				return workloads, "Draining", nil
+			}
+			return workloads, "Drained", nil
+		},
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if len(workloads) > 0 {
+			return fmt.Errorf("Zones %v still have workloads that must be moved or removed first:\n  * %s",
+				zoneNamesList(zoneNames), strings.Join(workloads, "\n  * "))
+		}
+		return err
+	}
+	return nil
+}
+
+// warnUnscheduleWorkloads logs a plan time warning when a zone that still has
+// workloads is being made unschedulable. Unscheduling a busy zone is how it is
+// taken out for maintenance, so the plan goes ahead.
+func warnUnscheduleWorkloads(config *Config, kind, name string, zoneNames map[string]bool) error {
+	workloads, err := zoneWorkloads(config, zoneNames)
+	if err != nil {
+		return err
+	}
+	if len(workloads) > 0 {
+		log.Printf("[WARN] %s %s is being made unschedulable but still has %d workloads:\n  * %s",
+			kind, name, len(workloads), strings.Join(workloads, "\n  * "))
+	}
+	return nil
+}
+
+func zoneNamesList(zoneNames map[string]bool) []string {
+	names := make([]string, 0, len(zoneNames))
+	for name := range zoneNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
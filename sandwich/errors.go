@@ -0,0 +1,172 @@
+package sandwich
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+// API attribute names that do not match the provider attribute they come from
+var apiAttributeNames = map[string]map[string]string{
+	"sandwich_compute_instance": {
+		"initial_volumes": "volumes",
+	},
+	"sandwich_compute_volume": {
+		"instance_name": "attached_to",
+	},
+	"sandwich_iam_system_policy": {
+		"bindings": "binding",
+	},
+	"sandwich_iam_project_policy": {
+		"bindings": "binding",
+	},
+}
+
+// The API collection each resource type is authorized against
+var apiPermissionPrefixes = map[string]string{
+	"sandwich_location_region":                 "regions",
+	"sandwich_location_zone":                   "zones",
+	"sandwich_compute_network":                 "networks",
+	"sandwich_compute_network_port_gc":         "network-ports",
+	"sandwich_compute_image":                   "images",
+	"sandwich_compute_image_member":            "images:members",
+	"sandwich_compute_keypair":                 "keypairs",
+	"sandwich_compute_flavor":                  "flavors",
+	"sandwich_compute_instance":                "instances",
+	"sandwich_compute_volume":                  "volumes",
+	"sandwich_compute_volume_clone":            "volumes",
+	"sandwich_iam_project":                     "projects",
+	"sandwich_iam_project_quota":               "projects:quota",
+	"sandwich_iam_system_role":                 "roles",
+	"sandwich_iam_project_role":                "roles",
+	"sandwich_iam_system_service_account":      "serviceaccounts",
+	"sandwich_iam_project_service_account":     "serviceaccounts",
+	"sandwich_iam_system_service_account_key":  "serviceaccounts:keys",
+	"sandwich_iam_project_service_account_key": "serviceaccounts:keys",
+	"sandwich_iam_system_policy":               "policy",
+	"sandwich_iam_system_policy_binding":       "policy",
+	"sandwich_iam_system_policy_member":        "policy",
+	"sandwich_iam_project_policy":              "policy",
+	"sandwich_iam_project_policy_binding":      "policy",
+	"sandwich_iam_project_policy_member":       "policy",
+	"sandwich_region":                          "regions",
+	"sandwich_network":                         "networks",
+	"sandwich_compute_network_ports":           "network-ports",
+	"sandwich_network_utilization":             "network-ports",
+	"sandwich_zone_capacity":                   "instances",
+	"sandwich_permissions":                     "permissions",
+}
+
+var apiPermissionVerbs = map[string]string{
+	"create": "create",
+	"read":   "get",
+	"update": "update",
+	"delete": "delete",
+}
+
+func withAPIErrors(resourceType string, r *schema.Resource) *schema.Resource {
+	r.Create = wrapAPIErrors(resourceType, "create", r.Create)
+	r.Read = wrapAPIErrors(resourceType, "read", r.Read)
+	r.Update = wrapAPIErrors(resourceType, "update", r.Update)
+	r.Delete = wrapAPIErrors(resourceType, "delete", r.Delete)
+	return r
+}
+
+func wrapAPIErrors(resourceType, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+		return translateAPIError(err, resourceType, operation, resourceObjectName(d))
+	}
+}
+
+func resourceObjectName(d *schema.ResourceData) string {
+	if d.Id() != "" {
+		return d.Id()
+	}
+	if name, ok := d.GetOk("name"); ok {
+		return name.(string)
+	}
+	return ""
+}
+
+func translateAPIError(err error, resourceType, operation, objectName string) error {
+	prefix := fmt.Sprintf("%s %s", resourceType, operation)
+	if objectName != "" {
+		prefix = fmt.Sprintf("%s %q", prefix, objectName)
+	}
+
+	apiError, ok := err.(api.APIError)
+	if !ok {
+		return fmt.Errorf("%s: %s", prefix, err)
+	}
+
+	status := apiError.Status
+	if status == "" {
+		status = http.StatusText(apiError.StatusCode)
+	}
+	message := fmt.Sprintf("%s: HTTP %d %s", prefix, apiError.StatusCode, status)
+
+	if apiError.StatusCode == http.StatusForbidden {
+		if permission := apiPermissionHint(resourceType, operation); permission != "" {
+			message += fmt.Sprintf(": the token is likely missing the %q permission", permission)
+		}
+	}
+
+	if len(apiError.Errors) == 0 {
+		if apiError.Message != "" {
+			message += ": " + apiError.Message
+		}
+		return fmt.Errorf("%s", message)
+	}
+
+	for _, dataError := range apiError.Errors {
+		attribute := dataError.Source.Parameter
+		if dataError.Source.Pointer != "" {
+			attribute = apiPointerToAttribute(resourceType, dataError.Source.Pointer)
+		}
+		if attribute != "" {
+			message += fmt.Sprintf("\n  * %s: %s", attribute, dataError.Detail)
+		} else {
+			message += fmt.Sprintf("\n  * %s", dataError.Detail)
+		}
+	}
+
+	return fmt.Errorf("%s", message)
+}
+
+// apiPointerToAttribute turns a JSON pointer such as /data/attributes/bindings/0/members
+// into the matching provider attribute path, binding.0.members
+func apiPointerToAttribute(resourceType, pointer string) string {
+	pointer = strings.TrimPrefix(pointer, "/data/attributes")
+	pointer = strings.TrimPrefix(pointer, "/")
+
+	parts := strings.Split(pointer, "/")
+	if renamed, ok := apiAttributeNames[resourceType][parts[0]]; ok {
+		parts[0] = renamed
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func apiPermissionHint(resourceType, operation string) string {
+	prefix, ok := apiPermissionPrefixes[resourceType]
+	if !ok {
+		return ""
+	}
+	if strings.HasSuffix(prefix, "policy") {
+		if operation == "read" {
+			return prefix + ":get"
+		}
+		return prefix + ":set"
+	}
+	return prefix + ":" + apiPermissionVerbs[operation]
+}
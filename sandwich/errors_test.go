@@ -0,0 +1,139 @@
+package sandwich
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func TestAPIPointerToAttribute(t *testing.T) {
+	cases := []struct {
+		name         string
+		resourceType string
+		pointer      string
+		want         string
+	}{
+		{
+			name:         "plain attribute",
+			resourceType: "sandwich_compute_network",
+			pointer:      "/data/attributes/cidr",
+			want:         "cidr",
+		},
+		{
+			name:         "renamed attribute",
+			resourceType: "sandwich_compute_volume",
+			pointer:      "/data/attributes/instance_name",
+			want:         "attached_to",
+		},
+		{
+			name:         "renamed nested attribute",
+			resourceType: "sandwich_iam_project_policy",
+			pointer:      "/data/attributes/bindings/0/members",
+			want:         "binding.0.members",
+		},
+		{
+			name:         "rename of another resource is ignored",
+			resourceType: "sandwich_compute_network",
+			pointer:      "/data/attributes/bindings/0/role",
+			want:         "bindings.0.role",
+		},
+		{
+			name:         "pointer outside the attributes",
+			resourceType: "sandwich_compute_instance",
+			pointer:      "/name",
+			want:         "name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := apiPointerToAttribute(tc.resourceType, tc.pointer); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTranslateAPIError(t *testing.T) {
+	apiError := func(statusCode int, body string) error {
+		err, parseErr := api.ParseErrors(statusCode, []byte(body))
+		if parseErr != nil {
+			t.Fatal(parseErr)
+		}
+		return err
+	}
+
+	cases := []struct {
+		name         string
+		err          error
+		resourceType string
+		operation    string
+		objectName   string
+		want         string
+	}{
+		{
+			name:         "not an API error",
+			err:          errors.New("connection refused"),
+			resourceType: "sandwich_compute_network",
+			operation:    "create",
+			objectName:   "net-a",
+			want:         `sandwich_compute_network create "net-a": connection refused`,
+		},
+		{
+			name:         "message only",
+			err:          apiError(http.StatusConflict, `{"status": "Conflict", "message": "network is in use"}`),
+			resourceType: "sandwich_compute_network",
+			operation:    "delete",
+			objectName:   "net-a",
+			want:         `sandwich_compute_network delete "net-a": HTTP 409 Conflict: network is in use`,
+		},
+		{
+			name:         "status text from the code",
+			err:          apiError(http.StatusNotFound, `{}`),
+			resourceType: "sandwich_compute_network",
+			operation:    "read",
+			want:         `sandwich_compute_network read: HTTP 404 Not Found`,
+		},
+		{
+			name:         "forbidden names the permission",
+			err:          apiError(http.StatusForbidden, `{"status": "Forbidden"}`),
+			resourceType: "sandwich_compute_volume",
+			operation:    "update",
+			objectName:   "vol-a",
+			want:         `sandwich_compute_volume update "vol-a": HTTP 403 Forbidden: the token is likely missing the "volumes:update" permission`,
+		},
+		{
+			name:         "forbidden on a policy",
+			err:          apiError(http.StatusForbidden, `{"status": "Forbidden"}`),
+			resourceType: "sandwich_iam_system_policy",
+			operation:    "create",
+			want:         `sandwich_iam_system_policy create: HTTP 403 Forbidden: the token is likely missing the "policy:set" permission`,
+		},
+		{
+			name: "data errors are mapped to attributes",
+			err: apiError(http.StatusBadRequest, `{"status": "Bad Request", "errors": [
+				{"detail": "not a valid member", "source": {"pointer": "/data/attributes/bindings/1/members"}},
+				{"detail": "must be positive", "source": {"parameter": "limit"}},
+				{"detail": "something else went wrong"}
+			]}`),
+			resourceType: "sandwich_iam_project_policy",
+			operation:    "update",
+			objectName:   "project-a",
+			want: `sandwich_iam_project_policy update "project-a": HTTP 400 Bad Request` +
+				"\n  * binding.1.members: not a valid member" +
+				"\n  * limit: must be positive" +
+				"\n  * something else went wrong",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := translateAPIError(tc.err, tc.resourceType, tc.operation, tc.objectName)
+			if err.Error() != tc.want {
+				t.Fatalf("expected:\n%s\ngot:\n%s", tc.want, err)
+			}
+		})
+	}
+}
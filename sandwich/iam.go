@@ -1,27 +1,310 @@
 package sandwich
 
 import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/mutexkv"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/sandwichcloud/deli-cli/api"
 	"github.com/sandwichcloud/deli-cli/api/client"
 )
 
 var iamMutexKV = mutexkv.NewMutexKV()
 
+const iamSystemPolicyMutexKey = "system"
+
+// Bindings of this role are kept when a policy is cleared so the project stays manageable
+const iamOwnerRole = "owner"
+
+func iamProjectPolicyMutexKey(projectName string) string {
+	return "projects/" + projectName
+}
+
 type iamPolicyModifyFunc func(policy *api.Policy)
 
-func iamReadModifyWrite(mutexKey string, policyClient client.PolicyClientInterface, modify iamPolicyModifyFunc) error {
+func iamReadModifyWrite(mutexKey string, policyClient client.PolicyClientInterface, maxRetries int, modify iamPolicyModifyFunc) error {
 	iamMutexKV.Lock(mutexKey)
 	defer iamMutexKV.Unlock(mutexKey)
 
-	policy, err := policyClient.Get()
-	if err != nil {
-		return err
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		policy, err := policyClient.Get()
+		if err != nil {
+			return err
+		}
+
+		// The API rejects the write if the policy changed since this version was read
+		resourceVersion := policy.ResourceVersion
+		modify(policy)
+		policy.ResourceVersion = resourceVersion
+
+		err = policyClient.Set(*policy)
+		if err == nil {
+			return nil
+		}
+		if !isPolicyConflict(err) {
+			return err
+		}
+		if attempt >= maxRetries {
+			return fmt.Errorf("Policy %s was modified concurrently, giving up after %d retries: %s", mutexKey, maxRetries, err)
+		}
+
+		log.Printf("[DEBUG] Policy %s was modified concurrently (version %s), retrying in %s", mutexKey, resourceVersion, backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > 30*time.Second {
+			backoff = 30 * time.Second
+		}
+	}
+}
+
+func expandIAMBindings(bindings []interface{}) []api.PolicyBinding {
+	newBindings := make([]api.PolicyBinding, 0)
+	for _, b := range bindings {
+		binding := b.(map[string]interface{})
+		newBindings = append(newBindings, api.PolicyBinding{
+			Role:    binding["role"].(string),
+			Members: expandIAMMembers(binding["members"].(*schema.Set)),
+		})
+	}
+	return newBindings
+}
+
+func expandIAMMembers(members *schema.Set) []string {
+	result := make([]string, 0)
+	for _, member := range members.List() {
+		result = append(result, member.(string))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func uniqueIAMMembers(members []string) []string {
+	seen := map[string]bool{}
+	result := make([]string, 0)
+	for _, member := range members {
+		if !seen[member] {
+			seen[member] = true
+			result = append(result, member)
+		}
+	}
+	return result
+}
+
+func flattenIAMBindings(bindings []api.PolicyBinding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, binding := range bindings {
+		result = append(result, map[string]interface{}{
+			"role":    binding.Role,
+			"members": schema.NewSet(schema.HashString, stringsToInterfaces(binding.Members)),
+		})
 	}
-	modify(policy)
-	err = policyClient.Set(*policy)
-	if err != nil {
-		return err
+	return result
+}
+
+// iamDestroyPolicy puts a policy back to its snapshot, or clears every binding
+// but the owner ones. Only project policies can be cleared.
+func iamDestroyPolicy(d *schema.ResourceData, mutexKey string, policyClient client.PolicyClientInterface, maxRetries int) error {
+	restoreBindings := expandIAMBindings(d.Get("restore_binding").([]interface{}))
+	onDestroy := d.Get("on_destroy").(string)
+	if onDestroy == "restore" && !d.Get("restore_recorded").(bool) {
+		// Imported policies and state written before snapshots existed have nothing to restore
+		log.Printf("[WARN] No policy snapshot was recorded for %s, leaving its bindings in place", mutexKey)
+		return nil
+	}
+
+	return iamReadModifyWrite(mutexKey, policyClient, maxRetries, func(policy *api.Policy) {
+		if onDestroy == "restore" {
+			policy.Bindings = restoreBindings
+			return
+		}
+
+		ownerBindings := make([]api.PolicyBinding, 0)
+		for _, binding := range policy.Bindings {
+			if binding.Role == iamOwnerRole {
+				ownerBindings = append(ownerBindings, binding)
+			}
+		}
+		policy.Bindings = ownerBindings
+	})
+}
+
+func isPolicyConflict(err error) bool {
+	if apiError, ok := err.(api.APIError); ok {
+		return apiError.StatusCode == http.StatusConflict || apiError.StatusCode == http.StatusPreconditionFailed
 	}
+	return false
+}
+
+// Authoritative resources own every member of the roles they manage, so mixing
+// them with other IAM resources for the same role makes each apply undo the last
+var iamAuthoritativeResources = map[string]bool{
+	"sandwich_iam_system_policy":          true,
+	"sandwich_iam_system_policy_binding":  true,
+	"sandwich_iam_project_policy":         true,
+	"sandwich_iam_project_policy_binding": true,
+}
+
+// iamClaim is what a single IAM resource in the configuration manages in a policy
+type iamClaim struct {
+	resourceType string
+	roles        []string // nil when every role of the policy is managed
+}
+
+// iamClaimRegistry records which IAM resources in the configuration manage
+// which roles so conflicting resources can be rejected at plan time. Claims are
+// keyed by policy, the project name or "" for the system policy, and then by
+// resource address so a resource planned again replaces its own claim.
+type iamClaimRegistry struct {
+	lock   sync.Mutex
+	claims map[string]map[string]iamClaim
+}
+
+func newIAMClaimRegistry() *iamClaimRegistry {
+	return &iamClaimRegistry{
+		claims: map[string]map[string]iamClaim{},
+	}
+}
+
+func (r *iamClaimRegistry) Claim(address, resourceType, projectName string, roles []string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.claims[projectName]; !ok {
+		r.claims[projectName] = map[string]iamClaim{}
+	}
+	claim := iamClaim{resourceType: resourceType, roles: roles}
+	r.claims[projectName][address] = claim
+
+	addresses := make([]string, 0, len(r.claims[projectName]))
+	for otherAddress := range r.claims[projectName] {
+		addresses = append(addresses, otherAddress)
+	}
+	sort.Strings(addresses)
+
+	for _, otherAddress := range addresses {
+		other := r.claims[projectName][otherAddress]
+		if otherAddress == address {
+			continue
+		}
+		if !iamAuthoritativeResources[resourceType] && !iamAuthoritativeResources[other.resourceType] {
+			continue
+		}
+		managed, overlap := iamOverlappingRole(claim, other)
+		if !overlap {
+			continue
+		}
+
+		policy := "the system policy"
+		if projectName != "" {
+			policy = fmt.Sprintf("the policy of project %s", projectName)
+		}
+		conflict := "Both are authoritative and each would remove the members set by the other on every apply."
+		if !iamAuthoritativeResources[resourceType] {
+			conflict = fmt.Sprintf("%s is authoritative and would remove the members added by %s on every apply.", otherAddress, address)
+		} else if !iamAuthoritativeResources[other.resourceType] {
+			conflict = fmt.Sprintf("%s is authoritative and would remove the members added by %s on every apply.", address, otherAddress)
+		}
+		return fmt.Errorf("%s and %s both manage %s in %s. %s Manage it with only one of them.",
+			address, otherAddress, managed, policy, conflict)
+	}
+
 	return nil
 }
+
+// iamOverlappingRole describes a role both claims manage
+func iamOverlappingRole(a, b iamClaim) (string, bool) {
+	switch {
+	case a.roles == nil && b.roles == nil:
+		return "every role", true
+	case a.roles == nil && len(b.roles) > 0:
+		return "role " + b.roles[0], true
+	case b.roles == nil && len(a.roles) > 0:
+		return "role " + a.roles[0], true
+	}
+	for _, role := range a.roles {
+		if stringInSlice(role, b.roles) {
+			return "role " + role, true
+		}
+	}
+	return "", false
+}
+
+// iamClaimConfig registers the roles an IAM resource manages according to its
+// configuration. Values that are not known yet are skipped, they are checked
+// again when the resource is planned during apply.
+func iamClaimConfig(config *Config, info *terraform.InstanceInfo, c *terraform.ResourceConfig) error {
+	var projectName string
+	var roles []string
+
+	switch info.Type {
+	case "sandwich_iam_system_policy":
+	case "sandwich_iam_system_policy_binding", "sandwich_iam_system_policy_member":
+		role, ok := iamConfigString(c, "role")
+		if !ok {
+			return nil
+		}
+		roles = []string{role}
+	case "sandwich_iam_project_policy":
+		name, ok := iamConfigProjectName(config, c, "project_name")
+		if !ok {
+			return nil
+		}
+		projectName = name
+	case "sandwich_iam_project_policy_binding", "sandwich_iam_project_policy_member":
+		name, ok := iamConfigProjectName(config, c, "project_name")
+		if !ok {
+			return nil
+		}
+		role, ok := iamConfigString(c, "role")
+		if !ok {
+			return nil
+		}
+		projectName = name
+		roles = []string{role}
+	case "sandwich_iam_project":
+		if _, ok := c.Get("owners"); !ok {
+			return nil
+		}
+		name, ok := iamConfigString(c, "name")
+		if !ok {
+			return nil
+		}
+		projectName = name
+		roles = []string{iamOwnerRole}
+	default:
+		return nil
+	}
+
+	return config.iamClaims.Claim(info.HumanId(), info.Type, projectName, roles)
+}
+
+func iamConfigString(c *terraform.ResourceConfig, key string) (string, bool) {
+	if c.IsComputed(key) {
+		return "", false
+	}
+	value, ok := c.Get(key)
+	if !ok {
+		return "", false
+	}
+	result, ok := value.(string)
+	return result, ok && result != ""
+}
+
+// iamConfigProjectName falls back to the provider project when the project is
+// left out, like getProject does on create
+func iamConfigProjectName(config *Config, c *terraform.ResourceConfig, key string) (string, bool) {
+	if c.IsComputed(key) {
+		return "", false
+	}
+	if _, ok := c.Get(key); !ok {
+		return config.ProjectName, config.ProjectName != ""
+	}
+	return iamConfigString(c, key)
+}
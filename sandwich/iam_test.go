@@ -0,0 +1,143 @@
+package sandwich
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+type iamTestResource struct {
+	address string
+	raw     map[string]interface{}
+}
+
+func TestIAMClaimConfig(t *testing.T) {
+	cases := []struct {
+		name      string
+		resources []iamTestResource
+		wantErr   string
+	}{
+		{
+			name: "project policy and a member of another role",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy.all", map[string]interface{}{"project_name": "project-a"}},
+				{"sandwich_iam_project_policy_member.dev", map[string]interface{}{"project_name": "project-a", "role": "developer", "member": "user:alice"}},
+			},
+			wantErr: "sandwich_iam_project_policy.all is authoritative and would remove the members added by sandwich_iam_project_policy_member.dev",
+		},
+		{
+			name: "two bindings of the same role",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy_binding.a", map[string]interface{}{"project_name": "project-a", "role": "developer"}},
+				{"sandwich_iam_project_policy_binding.b", map[string]interface{}{"project_name": "project-a", "role": "developer"}},
+			},
+			wantErr: "Both are authoritative",
+		},
+		{
+			name: "two system policies",
+			resources: []iamTestResource{
+				{"sandwich_iam_system_policy.a", map[string]interface{}{}},
+				{"module.iam.sandwich_iam_system_policy.b", map[string]interface{}{}},
+			},
+			wantErr: "both manage every role in the system policy",
+		},
+		{
+			name: "binding and member using the provider project",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy_binding.dev", map[string]interface{}{"role": "developer"}},
+				{"sandwich_iam_project_policy_member.dev", map[string]interface{}{"role": "developer", "member": "user:alice"}},
+			},
+			wantErr: "role developer in the policy of project provider-project",
+		},
+		{
+			name: "project owners and an owner binding",
+			resources: []iamTestResource{
+				{"sandwich_iam_project.a", map[string]interface{}{"name": "project-a", "owners": []interface{}{"user:alice"}}},
+				{"sandwich_iam_project_policy_binding.owners", map[string]interface{}{"project_name": "project-a", "role": "owner"}},
+			},
+			wantErr: "role owner",
+		},
+		{
+			name: "members of the same role",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy_member.alice", map[string]interface{}{"project_name": "project-a", "role": "developer", "member": "user:alice"}},
+				{"sandwich_iam_project_policy_member.bob", map[string]interface{}{"project_name": "project-a", "role": "developer", "member": "user:bob"}},
+			},
+		},
+		{
+			name: "bindings of different roles",
+			resources: []iamTestResource{
+				{"sandwich_iam_system_policy_binding.admins", map[string]interface{}{"role": "admin"}},
+				{"sandwich_iam_system_policy_binding.viewers", map[string]interface{}{"role": "viewer"}},
+			},
+		},
+		{
+			name: "policies of different projects",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy.a", map[string]interface{}{"project_name": "project-a"}},
+				{"sandwich_iam_project_policy.b", map[string]interface{}{"project_name": "project-b"}},
+				{"sandwich_iam_system_policy.all", map[string]interface{}{}},
+			},
+		},
+		{
+			name: "the same resource planned again",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy.a", map[string]interface{}{"project_name": "project-a"}},
+				{"sandwich_iam_project_policy.a", map[string]interface{}{"project_name": "project-a"}},
+			},
+		},
+		{
+			name: "unknown project",
+			resources: []iamTestResource{
+				{"sandwich_iam_project_policy_binding.dev", map[string]interface{}{"project_name": "project-a", "role": "developer"}},
+				{"sandwich_iam_project_policy_member.dev", map[string]interface{}{"project_name": config.UnknownVariableValue, "role": "developer", "member": "user:alice"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := &Config{ProjectName: "provider-project", iamClaims: newIAMClaimRegistry()}
+
+			var err error
+			for _, resource := range tc.resources {
+				rawConfig, configErr := config.NewRawConfig(resource.raw)
+				if configErr != nil {
+					t.Fatal(configErr)
+				}
+				info := iamTestInstanceInfo(resource.address)
+				if err = iamClaimConfig(meta, info, terraform.NewResourceConfig(rawConfig)); err != nil {
+					break
+				}
+			}
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// iamTestInstanceInfo builds the instance info Terraform passes for an address
+// such as module.iam.sandwich_iam_system_policy.b
+func iamTestInstanceInfo(address string) *terraform.InstanceInfo {
+	parts := strings.Split(address, ".")
+	modulePath := []string{"root"}
+	for len(parts) > 2 && parts[0] == "module" {
+		modulePath = append(modulePath, parts[1])
+		parts = parts[2:]
+	}
+	return &terraform.InstanceInfo{
+		Id:         strings.Join(parts, "."),
+		Type:       parts[0],
+		ModulePath: modulePath,
+	}
+}
@@ -0,0 +1,129 @@
+package sandwich
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(ratePerSecond int) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(ratePerSecond),
+		burst:  float64(ratePerSecond),
+		tokens: float64(ratePerSecond),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) Wait() {
+	for {
+		b.lock.Lock()
+		now := time.Now()
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.lock.Unlock()
+			return
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.lock.Unlock()
+		time.Sleep(wait)
+	}
+}
+
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	bucket    *tokenBucket
+}
+
+func newRateLimitedTransport(transport http.RoundTripper, requestsPerSecond int) http.RoundTripper {
+	if requestsPerSecond <= 0 {
+		return transport
+	}
+	return &rateLimitedTransport{
+		transport: transport,
+		bucket:    newTokenBucket(requestsPerSecond),
+	}
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.bucket.Wait()
+	return t.transport.RoundTrip(req)
+}
+
+type semaphore chan struct{}
+
+func (s semaphore) Acquire() {
+	if s != nil {
+		s <- struct{}{}
+	}
+}
+
+func (s semaphore) Release() {
+	if s != nil {
+		<-s
+	}
+}
+
+// createLimiter caps how many objects are being created at once, both in
+// total and within a single zone.
+type createLimiter struct {
+	global     semaphore
+	perZone    int
+	zonesLock  sync.Mutex
+	zoneLimits map[string]semaphore
+}
+
+func newCreateLimiter(maxConcurrentCreates, maxConcurrentCreatesPerZone int) *createLimiter {
+	l := &createLimiter{
+		perZone:    maxConcurrentCreatesPerZone,
+		zoneLimits: map[string]semaphore{},
+	}
+	if maxConcurrentCreates > 0 {
+		l.global = make(semaphore, maxConcurrentCreates)
+	}
+	return l
+}
+
+func (l *createLimiter) zone(zoneName string) semaphore {
+	if zoneName == "" || l.perZone <= 0 {
+		return nil
+	}
+
+	l.zonesLock.Lock()
+	defer l.zonesLock.Unlock()
+
+	if _, ok := l.zoneLimits[zoneName]; !ok {
+		l.zoneLimits[zoneName] = make(semaphore, l.perZone)
+	}
+	return l.zoneLimits[zoneName]
+}
+
+// Acquire blocks until a create slot is free and returns the func that frees it.
+// The zone slot is taken first so a full zone doesn't hold on to a global slot.
+func (l *createLimiter) Acquire(zoneName string) func() {
+	start := time.Now()
+	zone := l.zone(zoneName)
+	zone.Acquire()
+	l.global.Acquire()
+
+	if waited := time.Since(start); waited > time.Second {
+		log.Printf("[DEBUG] Waited %s for a create slot in zone %q", waited, zoneName)
+	}
+
+	return func() {
+		l.global.Release()
+		zone.Release()
+	}
+}
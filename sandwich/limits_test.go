@@ -0,0 +1,184 @@
+package sandwich
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketWait(t *testing.T) {
+	cases := []struct {
+		name          string
+		ratePerSecond int
+		requests      int
+		minDuration   time.Duration
+		maxDuration   time.Duration
+	}{
+		{
+			name:          "within the burst",
+			ratePerSecond: 20,
+			requests:      20,
+			maxDuration:   100 * time.Millisecond,
+		},
+		{
+			name:          "past the burst",
+			ratePerSecond: 20,
+			requests:      30,
+			minDuration:   450 * time.Millisecond,
+			maxDuration:   2 * time.Second,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bucket := newTokenBucket(tc.ratePerSecond)
+
+			start := time.Now()
+			for i := 0; i < tc.requests; i++ {
+				bucket.Wait()
+			}
+			elapsed := time.Since(start)
+
+			if elapsed < tc.minDuration || elapsed > tc.maxDuration {
+				t.Fatalf("expected %d requests to take between %s and %s, took %s", tc.requests, tc.minDuration, tc.maxDuration, elapsed)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewRateLimitedTransport(t *testing.T) {
+	cases := []struct {
+		name              string
+		requestsPerSecond int
+		wantWrapped       bool
+	}{
+		{name: "disabled", requestsPerSecond: 0},
+		{name: "negative", requestsPerSecond: -1},
+		{name: "enabled", requestsPerSecond: 5, wantWrapped: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			var inner roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+				calls++
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			}
+
+			transport := newRateLimitedTransport(inner, tc.requestsPerSecond)
+			if _, wrapped := transport.(*rateLimitedTransport); wrapped != tc.wantWrapped {
+				t.Fatalf("expected wrapped to be %t, got %T", tc.wantWrapped, transport)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, "http://sandwich.local/", nil)
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK || calls != 1 {
+				t.Fatalf("expected one request to reach the inner transport, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestCreateLimiterAcquire(t *testing.T) {
+	cases := []struct {
+		name           string
+		maxCreates     int
+		maxPerZone     int
+		zones          []string
+		wantMaxTotal   int
+		wantMaxPerZone int // an upper bound, which zones win the slots is not fixed
+	}{
+		{
+			name:           "global limit",
+			maxCreates:     2,
+			zones:          []string{"zone-a", "zone-a", "zone-b", "zone-b", "zone-c", "zone-c"},
+			wantMaxTotal:   2,
+			wantMaxPerZone: 2,
+		},
+		{
+			name:           "zone limit",
+			maxPerZone:     1,
+			zones:          []string{"zone-a", "zone-a", "zone-a", "zone-b", "zone-b", "zone-b"},
+			wantMaxTotal:   2,
+			wantMaxPerZone: 1,
+		},
+		{
+			name:           "both limits",
+			maxCreates:     3,
+			maxPerZone:     2,
+			zones:          []string{"zone-a", "zone-a", "zone-a", "zone-b", "zone-b", "zone-b"},
+			wantMaxTotal:   3,
+			wantMaxPerZone: 2,
+		},
+		{
+			name:           "unlimited",
+			zones:          []string{"zone-a", "zone-a", "zone-a", ""},
+			wantMaxTotal:   4,
+			wantMaxPerZone: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			limiter := newCreateLimiter(tc.maxCreates, tc.maxPerZone)
+
+			var lock sync.Mutex
+			total, maxTotal := 0, 0
+			perZone, maxPerZone := map[string]int{}, 0
+
+			// Every create holds its slot until all of them were started or blocked
+			var started, wg sync.WaitGroup
+			started.Add(len(tc.zones))
+			hold := make(chan struct{})
+			for _, zoneName := range tc.zones {
+				wg.Add(1)
+				go func(zoneName string) {
+					defer wg.Done()
+					started.Done()
+					release := limiter.Acquire(zoneName)
+					defer release()
+
+					lock.Lock()
+					total++
+					perZone[zoneName]++
+					if total > maxTotal {
+						maxTotal = total
+					}
+					if perZone[zoneName] > maxPerZone {
+						maxPerZone = perZone[zoneName]
+					}
+					lock.Unlock()
+
+					<-hold
+					time.Sleep(10 * time.Millisecond)
+
+					lock.Lock()
+					total--
+					perZone[zoneName]--
+					lock.Unlock()
+				}(zoneName)
+			}
+			started.Wait()
+			time.Sleep(50 * time.Millisecond)
+			close(hold)
+			wg.Wait()
+
+			if maxTotal != tc.wantMaxTotal {
+				t.Fatalf("expected at most %d creates at once, got %d", tc.wantMaxTotal, maxTotal)
+			}
+			if maxPerZone > tc.wantMaxPerZone {
+				t.Fatalf("expected at most %d creates at once in a zone, got %d", tc.wantMaxPerZone, maxPerZone)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package sandwich
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/logging"
+)
+
+const redacted = "[REDACTED]"
+
+// Body logging is capped so large uploads don't flood the log
+const maxLoggedBodySize = 64 * 1024
+
+var redactedHeaders = []string{"Authorization"}
+
+var redactedFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"password":      true,
+	"user_data":     true,
+}
+
+type loggingTransport struct {
+	transport http.RoundTripper
+	logBodies bool
+
+	fileLock sync.Mutex
+	file     io.WriteCloser
+}
+
+type requestLogEntry struct {
+	Time            time.Time         `json:"time"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	StatusCode      int               `json:"status_code,omitempty"`
+	LatencyMS       int64             `json:"latency_ms"`
+	Error           string            `json:"error,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+}
+
+func newLoggingTransport(transport http.RoundTripper, logFile string) (*loggingTransport, error) {
+	t := &loggingTransport{
+		transport: transport,
+		logBodies: logging.LogLevel() == "TRACE",
+	}
+
+	if logFile != "" {
+		file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening log file %s: %s", logFile, err)
+		}
+		t.file = file
+	}
+
+	return t, nil
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	captureBodies := t.logBodies || t.file != nil
+	entry := requestLogEntry{
+		Time:   time.Now().UTC(),
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+
+	if captureBodies {
+		entry.RequestHeaders = redactHeaders(req.Header)
+		if req.Body != nil && !isJSONContentType(req.Header.Get("Content-Type")) {
+			// Uploads are streamed, reading them here would buffer the whole file
+			entry.RequestBody = fmt.Sprintf("<%d bytes of %s>", req.ContentLength, req.Header.Get("Content-Type"))
+		} else if req.Body != nil {
+			body, err := ioutil.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			entry.RequestBody = redactBody(req.Header.Get("Content-Type"), body)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	entry.LatencyMS = int64(time.Since(start) / time.Millisecond)
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.StatusCode = resp.StatusCode
+		if captureBodies {
+			entry.ResponseHeaders = redactHeaders(resp.Header)
+			body, readErr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return nil, readErr
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			entry.ResponseBody = redactBody(resp.Header.Get("Content-Type"), body)
+		}
+	}
+
+	t.log(entry)
+	return resp, err
+}
+
+func (t *loggingTransport) log(entry requestLogEntry) {
+	if entry.Error != "" {
+		log.Printf("[DEBUG] Sandwich API %s %s failed after %dms: %s", entry.Method, entry.URL, entry.LatencyMS, entry.Error)
+	} else {
+		log.Printf("[DEBUG] Sandwich API %s %s returned %d in %dms", entry.Method, entry.URL, entry.StatusCode, entry.LatencyMS)
+	}
+
+	if t.logBodies {
+		log.Printf("[TRACE] Sandwich API request %s %s\nHeaders: %v\nBody: %s", entry.Method, entry.URL, entry.RequestHeaders, entry.RequestBody)
+		log.Printf("[TRACE] Sandwich API response %s %s\nHeaders: %v\nBody: %s", entry.Method, entry.URL, entry.ResponseHeaders, entry.ResponseBody)
+	}
+
+	if t.file != nil {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			log.Printf("[WARN] Error encoding Sandwich API log entry: %s", err)
+			return
+		}
+
+		t.fileLock.Lock()
+		defer t.fileLock.Unlock()
+		if _, err := t.file.Write(append(line, '\n')); err != nil {
+			log.Printf("[WARN] Error writing Sandwich API log file: %s", err)
+		}
+	}
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := map[string]string{}
+	for k, v := range headers {
+		result[k] = strings.Join(v, ", ")
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := result[k]; ok {
+			result[k] = redacted
+		}
+	}
+	return result
+}
+
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if !isJSONContentType(contentType) {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return fmt.Sprintf("<%d bytes of invalid json>", len(body))
+	}
+
+	redactedBody, _ := json.Marshal(redactValue(data))
+	if len(redactedBody) > maxLoggedBodySize {
+		return string(redactedBody[:maxLoggedBodySize]) + "...<truncated>"
+	}
+	return string(redactedBody)
+}
+
+func isJSONContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json")
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if redactedFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,91 @@
+package sandwich
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "empty",
+			contentType: "application/json",
+			body:        "",
+			want:        "",
+		},
+		{
+			name:        "access token",
+			contentType: "application/json",
+			body:        `{"access_token": "secret", "expiry": "2018-06-01T00:00:00Z"}`,
+			want:        `{"access_token":"[REDACTED]","expiry":"2018-06-01T00:00:00Z"}`,
+		},
+		{
+			name:        "user data in a list",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"instances": [{"name": "web-1", "user_data": "#cloud-config"}, {"name": "web-2"}]}`,
+			want:        `{"instances":[{"name":"web-1","user_data":"[REDACTED]"},{"name":"web-2"}]}`,
+		},
+		{
+			name:        "redacted field holding an object",
+			contentType: "application/json",
+			body:        `{"password": {"old": "a", "new": "b"}}`,
+			want:        `{"password":"[REDACTED]"}`,
+		},
+		{
+			name:        "not json",
+			contentType: "application/octet-stream",
+			body:        "qcow2",
+			want:        "<5 bytes of application/octet-stream>",
+		},
+		{
+			name:        "invalid json",
+			contentType: "application/json",
+			body:        `{"access_token": `,
+			want:        "<17 bytes of invalid json>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactBody(tc.contentType, []byte(tc.body)); got != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRedactBodyTruncates(t *testing.T) {
+	body := `{"name": "` + strings.Repeat("a", maxLoggedBodySize) + `"}`
+
+	got := redactBody("application/json", []byte(body))
+	if !strings.HasSuffix(got, "...<truncated>") {
+		t.Fatalf("expected the body to be truncated, got %d bytes", len(got))
+	}
+	if len(got) != maxLoggedBodySize+len("...<truncated>") {
+		t.Fatalf("expected %d bytes, got %d", maxLoggedBodySize+len("...<truncated>"), len(got))
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization": []string{"Bearer secret"},
+		"Accept":        []string{"application/json", "text/plain"},
+	}
+
+	got := redactHeaders(headers)
+	if got["Authorization"] != redacted {
+		t.Fatalf("expected Authorization to be redacted, got %q", got["Authorization"])
+	}
+	if got["Accept"] != "application/json, text/plain" {
+		t.Fatalf("expected Accept to be kept, got %q", got["Accept"])
+	}
+	if headers.Get("Authorization") != "Bearer secret" {
+		t.Fatalf("expected the request headers to be left alone, got %q", headers.Get("Authorization"))
+	}
+}
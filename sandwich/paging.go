@@ -0,0 +1,245 @@
+package sandwich
+
+import (
+	"net/url"
+
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client"
+)
+
+const listPageSize = 100
+
+// pageLink is a link of a list response, the deli-cli link type is not exported
+type pageLink struct {
+	REL  string
+	HREF string
+}
+
+// pageFunc fetches the page starting at marker and returns its links and how
+// many items it held.
+type pageFunc func(marker string) ([]pageLink, int, error)
+
+// listAllPages calls fetch for every page until there is no next page.
+func listAllPages(fetch pageFunc) error {
+	marker := ""
+	for {
+		links, count, err := fetch(marker)
+		if err != nil {
+			return err
+		}
+
+		marker = ""
+		for _, link := range links {
+			if marker, err = nextPageMarker(link.REL, link.HREF); err != nil {
+				return err
+			}
+			if marker != "" {
+				break
+			}
+		}
+		if marker == "" || count == 0 {
+			return nil
+		}
+	}
+}
+
+// nextPageMarker returns the marker of the page a "next" link points to
+func nextPageMarker(rel, href string) (string, error) {
+	if rel != "next" {
+		return "", nil
+	}
+	nextURL, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	return nextURL.Query().Get("marker"), nil
+}
+
+func listAllInstances(instanceClient client.InstanceClientInterface) ([]api.Instance, error) {
+	var instances []api.Instance
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := instanceClient.List("", listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		instances = append(instances, page.Instances...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Instances), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+func listAllVolumes(volumeClient client.VolumeClientInterface) ([]api.Volume, error) {
+	var volumes []api.Volume
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := volumeClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		volumes = append(volumes, page.Volumes...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Volumes), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return volumes, nil
+}
+
+func listAllImages(imageClient client.ImageClientInterface) ([]api.Image, error) {
+	var images []api.Image
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := imageClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		images = append(images, page.Images...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Images), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func listAllKeypairs(keypairClient client.KeypairClientInterface) ([]api.Keypair, error) {
+	var keypairs []api.Keypair
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := keypairClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		keypairs = append(keypairs, page.KeyPairs...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.KeyPairs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keypairs, nil
+}
+
+func listAllServiceAccounts(serviceAccountClient client.ServiceAccountClientInterface) ([]api.ServiceAccount, error) {
+	var serviceAccounts []api.ServiceAccount
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := serviceAccountClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		serviceAccounts = append(serviceAccounts, page.ServiceAccounts...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.ServiceAccounts), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return serviceAccounts, nil
+}
+
+func listAllPermissions(permissionClient client.PermissionClientInterface) ([]api.Permissions, error) {
+	var permissions []api.Permissions
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := permissionClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		permissions = append(permissions, page.Permissions...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Permissions), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func listAllNetworkPorts(networkPortClient client.NetworkPortClientInterface) ([]api.NetworkPort, error) {
+	var networkPorts []api.NetworkPort
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := networkPortClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		networkPorts = append(networkPorts, page.NetworkPorts...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.NetworkPorts), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return networkPorts, nil
+}
+
+func listAllProjects(projectClient client.ProjectClientInterface) ([]api.Project, error) {
+	var projects []api.Project
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := projectClient.List(listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		projects = append(projects, page.Projects...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Projects), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func listAllZones(zoneClient client.ZoneClientInterface, regionName string) ([]api.Zone, error) {
+	var zones []api.Zone
+	err := listAllPages(func(marker string) ([]pageLink, int, error) {
+		page, err := zoneClient.List(regionName, listPageSize, marker)
+		if err != nil {
+			return nil, 0, err
+		}
+		zones = append(zones, page.Zones...)
+
+		links := make([]pageLink, 0, len(page.Links))
+		for _, link := range page.Links {
+			links = append(links, pageLink{REL: link.REL, HREF: link.HREF})
+		}
+		return links, len(page.Zones), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
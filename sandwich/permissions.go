@@ -0,0 +1,84 @@
+package sandwich
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/agext/levenshtein"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client"
+)
+
+// permissionCatalog lazily loads the API permission list once per provider run.
+type permissionCatalog struct {
+	once        sync.Once
+	err         error
+	permissions []api.Permissions
+	names       map[string]bool
+}
+
+func (c *permissionCatalog) Load(permissionClient client.PermissionClientInterface) ([]api.Permissions, error) {
+	c.once.Do(func() {
+		c.permissions, c.err = listAllPermissions(permissionClient)
+		c.names = map[string]bool{}
+		for _, permission := range c.permissions {
+			c.names[permission.Name] = true
+		}
+	})
+	return c.permissions, c.err
+}
+
+func closestPermission(name string, permissions []api.Permissions) string {
+	closest := ""
+	closestDistance := -1
+	for _, permission := range permissions {
+		distance := levenshtein.Distance(name, permission.Name, nil)
+		if closestDistance == -1 || distance < closestDistance {
+			closest = permission.Name
+			closestDistance = distance
+		}
+	}
+
+	// Anything further away than half the name is unlikely to be a typo
+	if closestDistance > len(name)/2 {
+		return ""
+	}
+	return closest
+}
+
+func resourceRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("permissions") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	permissions, err := config.permissionCatalog.Load(config.SandwichClient.Permission())
+	if err != nil {
+		log.Printf("[WARN] Unable to load the permission catalog, skipping permission validation: %s", err)
+		return nil
+	}
+
+	var unknown []string
+	for _, p := range d.Get("permissions").(*schema.Set).List() {
+		if !config.permissionCatalog.names[p.(string)] {
+			unknown = append(unknown, p.(string))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	message := "permissions: the following permissions do not exist:"
+	for _, name := range unknown {
+		if suggestion := closestPermission(name, permissions); suggestion != "" {
+			message += fmt.Sprintf("\n  * %s (did you mean %s?)", name, suggestion)
+		} else {
+			message += fmt.Sprintf("\n  * %s", name)
+		}
+	}
+	return fmt.Errorf("%s", message)
+}
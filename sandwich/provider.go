@@ -2,10 +2,11 @@ package sandwich
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
-func Provider() *schema.Provider {
-	return &schema.Provider{
+func Provider() terraform.ResourceProvider {
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_server": {
 				Type:     schema.TypeString,
@@ -20,35 +21,104 @@ func Provider() *schema.Provider {
 				Optional: true,
 				Default:  "",
 			},
+			"default_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"max_requests_per_second": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"max_concurrent_creates": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"max_concurrent_creates_per_zone": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"policy_conflict_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"sandwich_region":  dataSourceRegion(),
-			"sandwich_network": dataSourceNetwork(),
+			"sandwich_region":                      dataSourceRegion(),
+			"sandwich_network":                     dataSourceNetwork(),
+			"sandwich_compute_network_ports":       dataSourceNetworkPorts(),
+			"sandwich_network_utilization":         dataSourceNetworkUtilization(),
+			"sandwich_zone_capacity":               dataSourceZoneCapacity(),
+			"sandwich_iam_project_policy":          dataSourceProjectPolicy(),
+			"sandwich_permissions":                 dataSourcePermissions(),
+			"sandwich_iam_system_service_account":  dataSourceSystemServiceAccount(),
+			"sandwich_iam_project_service_account": dataSourceProjectServiceAccount(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"sandwich_location_region":             resourceRegion(),
-			"sandwich_location_zone":               resourceZone(),
-			"sandwich_compute_network":             resourceNetwork(),
-			"sandwich_compute_image":               resourceImage(),
-			"sandwich_compute_keypair":             resourceKeypair(),
-			"sandwich_compute_flavor":              resourceFlavor(),
-			"sandwich_compute_instance":            resourceInstance(),
-			"sandwich_compute_volume":              resourceVolume(),
-			"sandwich_iam_project":                 resourceProject(),
-			"sandwich_iam_project_quota":           resourceProjectQuota(),
-			"sandwich_iam_system_role":             resourceSystemRole(),
-			"sandwich_iam_project_role":            resourceProjectRole(),
-			"sandwich_iam_system_service_account":  resourceSystemServiceAccount(),
-			"sandwich_iam_project_service_account": resourceProjectServiceAccount(),
-			"sandwich_iam_system_policy":           resourceSystemPolicy(),
-			"sandwich_iam_system_policy_binding":   resourceSystemPolicyBinding(),
-			"sandwich_iam_system_policy_member":    resourceSystemPolicyMember(),
-			"sandwich_iam_project_policy":          resourceProjectPolicy(),
-			"sandwich_iam_project_policy_binding":  resourceProjectPolicyBinding(),
-			"sandwich_iam_project_policy_member":   resourceProjectPolicyMember(),
+			"sandwich_location_region":                 resourceRegion(),
+			"sandwich_location_zone":                   resourceZone(),
+			"sandwich_compute_network":                 resourceNetwork(),
+			"sandwich_compute_network_port_gc":         resourceNetworkPortGC(),
+			"sandwich_compute_image":                   resourceImage(),
+			"sandwich_compute_image_member":            resourceImageMember(),
+			"sandwich_compute_keypair":                 resourceKeypair(),
+			"sandwich_compute_flavor":                  resourceFlavor(),
+			"sandwich_compute_instance":                resourceInstance(),
+			"sandwich_compute_volume":                  resourceVolume(),
+			"sandwich_compute_volume_clone":            resourceVolumeClone(),
+			"sandwich_iam_project":                     resourceProject(),
+			"sandwich_iam_project_quota":               resourceProjectQuota(),
+			"sandwich_iam_system_role":                 resourceSystemRole(),
+			"sandwich_iam_project_role":                resourceProjectRole(),
+			"sandwich_iam_system_service_account":      resourceSystemServiceAccount(),
+			"sandwich_iam_project_service_account":     resourceProjectServiceAccount(),
+			"sandwich_iam_system_service_account_key":  resourceSystemServiceAccountKey(),
+			"sandwich_iam_project_service_account_key": resourceProjectServiceAccountKey(),
+			"sandwich_iam_system_policy":               resourceSystemPolicy(),
+			"sandwich_iam_system_policy_binding":       resourceSystemPolicyBinding(),
+			"sandwich_iam_system_policy_member":        resourceSystemPolicyMember(),
+			"sandwich_iam_project_policy":              resourceProjectPolicy(),
+			"sandwich_iam_project_policy_binding":      resourceProjectPolicyBinding(),
+			"sandwich_iam_project_policy_member":       resourceProjectPolicyMember(),
 		},
 		ConfigureFunc: configureProvider,
 	}
+
+	for name, dataSource := range provider.DataSourcesMap {
+		withAPIErrors(name, dataSource)
+	}
+	for name, resource := range provider.ResourcesMap {
+		withAPIErrors(name, resource)
+	}
+
+	return &sandwichProvider{provider}
+}
+
+// sandwichProvider sees the address and raw configuration of every planned
+// resource, which CustomizeDiff does not, to catch IAM resources that fight
+// over the same role.
+type sandwichProvider struct {
+	*schema.Provider
+}
+
+func (p *sandwichProvider) Diff(info *terraform.InstanceInfo, s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if config, ok := p.Meta().(*Config); ok {
+		if err := iamClaimConfig(config, info, c); err != nil {
+			return nil, err
+		}
+	}
+	return p.Provider.Diff(info, s, c)
 }
 
 func configureProvider(d *schema.ResourceData) (interface{}, error) {
@@ -56,6 +126,17 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		APIServer:   d.Get("api_server").(string),
 		Token:       d.Get("token").(string),
 		ProjectName: d.Get("project_name").(string),
+		LogFile:     d.Get("log_file").(string),
+		DefaultTags: map[string]string{},
+
+		MaxRequestsPerSecond:        d.Get("max_requests_per_second").(int),
+		MaxConcurrentCreates:        d.Get("max_concurrent_creates").(int),
+		MaxConcurrentCreatesPerZone: d.Get("max_concurrent_creates_per_zone").(int),
+		PolicyConflictRetries:       d.Get("policy_conflict_retries").(int),
+	}
+
+	for k, v := range d.Get("default_tags").(map[string]interface{}) {
+		config.DefaultTags[k] = v.(string)
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -1,32 +1,49 @@
 package sandwich
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/sandwichcloud/deli-cli/api"
 	"github.com/sandwichcloud/deli-cli/api/client"
+	"golang.org/x/net/context/ctxhttp"
 )
 
 func resourceImage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceImageCreate,
 		Read:   resourceImageRead,
+		Update: resourceImageUpdate,
 		Delete: resourceImageDelete,
 
+		CustomizeDiff: resourceImageCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
+			Create: schema.DefaultTimeout(60 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"project_name": {
 				Type:     schema.TypeString,
@@ -41,9 +58,38 @@ func resourceImage() *schema.Resource {
 			},
 			"file_name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_url"},
+			},
+			"source_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_path"},
+			},
+			"source_sha256": {
+				Type:     schema.TypeString,
+				Optional: true,
 				ForceNew: true,
 			},
+			"uploaded_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOneOf("private", "public", "shared"),
+			},
 		},
 	}
 }
@@ -61,6 +107,15 @@ func resourceImageCreate(d *schema.ResourceData, meta interface{}) error {
 	fileName := d.Get("file_name").(string)
 	d.Set("project_name", projectName)
 
+	if d.Get("source_path").(string) != "" || d.Get("source_url").(string) != "" {
+		fileName, err = resourceImageUpload(d, imageClient, regionName, fileName)
+		if err != nil {
+			return err
+		}
+	} else if fileName == "" {
+		return fmt.Errorf("one of file_name, source_path or source_url must be set")
+	}
+
 	image, err := imageClient.Create(name, regionName, fileName)
 	if err != nil {
 		return err
@@ -82,7 +137,7 @@ func resourceImageCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting for image (%s) to become ready: %s", image.Name, err)
 	}
 
-	return resourceImageRead(d, meta)
+	return resourceImageUpdate(d, meta)
 }
 
 func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
@@ -102,10 +157,42 @@ func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("region_name", image.RegionName)
 	d.Set("file_name", image.FileName)
+	d.Set("visibility", image.Visibility)
 
 	return nil
 }
 
+func resourceImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient := config.SandwichClient.Image(d.Get("project_name").(string))
+
+	// An unset visibility leaves whatever the API chose alone
+	visibility, ok := d.GetOk("visibility")
+	if !ok {
+		return resourceImageRead(d, meta)
+	}
+
+	image, err := imageClient.Get(d.Id())
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if image.Visibility != visibility.(string) {
+		err := imageClient.ActionSetVisibility(d.Id(), visibility.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceImageRead(d, meta)
+}
+
 func resourceImageDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	imageClient := config.SandwichClient.Image(d.Get("project_name").(string))
@@ -138,6 +225,145 @@ func resourceImageDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceImageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	sourcePath := d.Get("source_path").(string)
+
+	// A local file is hashed on every plan so changing its contents replaces the image
+	if sourcePath == "" || !d.NewValueKnown("source_path") {
+		return nil
+	}
+
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		// Build output is often gone by the time an existing image is planned again
+		if os.IsNotExist(err) && d.Id() != "" {
+			log.Printf("[WARN] Image source %s of image %s no longer exists, keeping the uploaded image", sourcePath, d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error opening image source %s: %s", sourcePath, err)
+	}
+	defer file.Close()
+
+	sum, err := imageSHA256(file)
+	if err != nil {
+		return fmt.Errorf("Error reading image source %s: %s", sourcePath, err)
+	}
+
+	if expected := d.Get("source_sha256").(string); d.NewValueKnown("source_sha256") && expected != "" && !strings.EqualFold(expected, sum) {
+		return fmt.Errorf("Image source %s has sha256 %s but source_sha256 is %s", sourcePath, sum, expected)
+	}
+
+	if d.Get("uploaded_sha256").(string) != sum {
+		return d.SetNew("uploaded_sha256", sum)
+	}
+	return nil
+}
+
+// resourceImageUpload streams source_path or source_url into the region's image
+// datastore and returns the file name it was stored under. The source is hashed
+// and checked against source_sha256 before anything is uploaded, so URLs are
+// downloaded to a temporary file first.
+func resourceImageUpload(d *schema.ResourceData, imageClient imageClientInterface, regionName, fileName string) (string, error) {
+	sourcePath := d.Get("source_path").(string)
+	sourceURL := d.Get("source_url").(string)
+
+	source := sourcePath
+	var file *os.File
+	var err error
+	if sourcePath != "" {
+		file, err = os.Open(sourcePath)
+		if err != nil {
+			return "", fmt.Errorf("Error opening image source %s: %s", sourcePath, err)
+		}
+	} else {
+		source = sourceURL
+		file, err = downloadImageSource(sourceURL, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return "", err
+		}
+		defer os.Remove(file.Name())
+	}
+	defer file.Close()
+
+	sum, err := imageSHA256(file)
+	if err != nil {
+		return "", fmt.Errorf("Error reading image source %s: %s", source, err)
+	}
+	if expected := d.Get("source_sha256").(string); expected != "" && !strings.EqualFold(expected, sum) {
+		return "", fmt.Errorf("Image source %s has sha256 %s but source_sha256 is %s, nothing was uploaded", source, sum, expected)
+	}
+	if planned := d.Get("uploaded_sha256").(string); planned != "" && planned != sum {
+		return "", fmt.Errorf("Image source %s changed after it was planned, its sha256 is now %s instead of %s", source, sum, planned)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("Error reading image source %s: %s", source, err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("Error reading image source %s: %s", source, err)
+	}
+
+	if fileName == "" {
+		sourceFile := sourcePath
+		if sourceURL != "" {
+			parsedURL, err := url.Parse(sourceURL)
+			if err != nil {
+				return "", err
+			}
+			sourceFile = parsedURL.Path
+		}
+		fileName = d.Get("name").(string) + path.Ext(sourceFile)
+	}
+
+	log.Printf("[DEBUG] Uploading image source %s to %s in region %s", source, fileName, regionName)
+	err = imageClient.Upload(regionName, fileName, file, info.Size(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return "", fmt.Errorf("Error uploading image source %s: %s", source, err)
+	}
+
+	d.Set("uploaded_sha256", sum)
+	d.Set("file_name", fileName)
+	return fileName, nil
+}
+
+// downloadImageSource fetches sourceURL into a temporary file, the caller removes it.
+func downloadImageSource(sourceURL string, timeout time.Duration) (*os.File, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	response, err := ctxhttp.Get(ctx, http.DefaultClient, sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading image source %s: %s", sourceURL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error downloading image source %s: HTTP %s", sourceURL, response.Status)
+	}
+
+	file, err := ioutil.TempFile("", "terraform-sandwich-image")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(file, response.Body); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, fmt.Errorf("Error downloading image source %s: %s", sourceURL, err)
+	}
+	return file, nil
+}
+
+func imageSHA256(file io.ReadSeeker) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func ImageRefreshFunc(imageClient client.ImageClientInterface, imageName string) func() (result interface{}, state string, err error) {
 	return func() (result interface{}, state string, err error) {
 		image, err := imageClient.Get(imageName)
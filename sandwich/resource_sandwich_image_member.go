@@ -0,0 +1,141 @@
+package sandwich
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func resourceImageMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageMemberCreate,
+		Read:   resourceImageMemberRead,
+		Delete: resourceImageMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceImageMemberImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_project_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+		},
+	}
+}
+
+func resourceImageMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	imageClient := config.SandwichClient.Image(projectName)
+	imageName := d.Get("image_name").(string)
+	memberProjectName := d.Get("member_project_name").(string)
+	d.Set("project_name", projectName)
+
+	image, err := imageClient.Get(imageName)
+	if err != nil {
+		return err
+	}
+	if image.Visibility != "shared" {
+		return fmt.Errorf("image %s has visibility %s, it must be shared before it can be shared with project %s", imageName, image.Visibility, memberProjectName)
+	}
+
+	err = imageClient.AddMember(imageName, memberProjectName)
+	if err != nil {
+		return err
+	}
+	d.SetId(projectName + "/" + imageName + "/" + memberProjectName)
+
+	return resourceImageMemberRead(d, meta)
+}
+
+func resourceImageMemberRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	parts := strings.Split(d.Id(), "/")
+	projectName, imageName, memberProjectName := parts[0], parts[1], parts[2]
+	imageClient := config.SandwichClient.Image(projectName)
+
+	members, err := imageClient.ListMembers(imageName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	found := false
+	for _, member := range members.Members {
+		if member.ProjectName == memberProjectName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project_name", projectName)
+	d.Set("image_name", imageName)
+	d.Set("member_project_name", memberProjectName)
+
+	return nil
+}
+
+func resourceImageMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if len(strings.Split(d.Id(), "/")) != 3 {
+		return nil, fmt.Errorf("Invalid image member id %q, expected {project_name}/{image_name}/{member_project_name}", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceImageMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	parts := strings.Split(d.Id(), "/")
+	projectName, imageName, memberProjectName := parts[0], parts[1], parts[2]
+	imageClient := config.SandwichClient.Image(projectName)
+
+	err := imageClient.RemoveMember(imageName, memberProjectName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
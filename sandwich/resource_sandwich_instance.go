@@ -2,6 +2,7 @@ package sandwich
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -16,6 +17,8 @@ func resourceInstance() *schema.Resource {
 		Read:   resourceInstanceRead,
 		Delete: resourceInstanceDelete,
 
+		CustomizeDiff: resourceInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -24,9 +27,10 @@ func resourceInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"project_name": {
 				Type:     schema.TypeString,
@@ -107,6 +111,13 @@ func resourceInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -117,6 +128,32 @@ func resourceInstance() *schema.Resource {
 	}
 }
 
+func resourceInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Tags can only be set on create, so changing default_tags leaves existing
+	// instances alone and only applies to new ones
+	if d.Id() != "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	config := meta.(*Config)
+	tagsAll := map[string]interface{}{}
+	for k, v := range config.DefaultTags {
+		tagsAll[k] = v
+	}
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tagsAll[k] = v
+	}
+
+	if reflect.DeepEqual(d.Get("tags_all"), tagsAll) {
+		return nil
+	}
+	return d.SetNew("tags_all", tagsAll)
+}
+
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	projectName, err := getProject(d, config)
@@ -143,6 +180,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		keypairNames = append(keypairNames, keypairName.(string))
 	}
 
+	for k, v := range config.DefaultTags {
+		tags[k] = v
+	}
+
 	for k, v := range d.Get("tags").(map[string]interface{}) {
 		tags[k] = v.(string)
 	}
@@ -155,6 +196,9 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
+	release := config.createLimiter.Acquire(zoneName)
+	defer release()
+
 	instance, err := instanceClient.Create(name, imageName, regionName, zoneName, networkName, serviceAccountName, flavorName, disk, keypairNames, initialVolumes, tags, userData)
 	if err != nil {
 		return err
@@ -218,12 +262,24 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		keypairNames = append(keypairNames, keypairName)
 	}
 
+	configuredTags := d.Get("tags").(map[string]interface{})
+	previousTagsAll := d.Get("tags_all").(map[string]interface{})
 	for k, v := range instance.Tags {
+		// Tags that come from the provider default_tags, now or when the instance
+		// was created, are tracked in tags_all
+		if _, ok := configuredTags[k]; !ok {
+			_, isDefault := config.DefaultTags[k]
+			_, wasDefault := previousTagsAll[k]
+			if isDefault || wasDefault {
+				continue
+			}
+		}
 		tags[k] = v
 	}
 
 	d.Set("keypair_names", keypairNames)
 	d.Set("tags", tags)
+	d.Set("tags_all", instance.Tags)
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package sandwich
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"time"
@@ -17,6 +18,8 @@ func resourceNetwork() *schema.Resource {
 		Read:   resourceNetworkRead,
 		Delete: resourceNetworkDelete,
 
+		CustomizeDiff: resourceNetworkCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -25,9 +28,10 @@ func resourceNetwork() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"region_name": {
 				Type:     schema.TypeString,
@@ -40,24 +44,28 @@ func resourceNetwork() *schema.Resource {
 				ForceNew: true,
 			},
 			"cidr": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
 			},
 			"gateway": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPAddress,
 			},
 			"pool_start": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPAddress,
 			},
 			"pool_end": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPAddress,
 			},
 			"dns_servers": {
 				Type:     schema.TypeList,
@@ -65,13 +73,45 @@ func resourceNetwork() *schema.Resource {
 				ForceNew: true,
 				MinItems: 1,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
 				},
 			},
 		},
 	}
 }
 
+func resourceNetworkCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cidr", "gateway", "pool_start", "pool_end"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	_, ipNet, err := net.ParseCIDR(d.Get("cidr").(string))
+	if err != nil {
+		return fmt.Errorf("cidr: %s", err)
+	}
+
+	for _, key := range []string{"gateway", "pool_start", "pool_end"} {
+		ip := net.ParseIP(d.Get(key).(string))
+		if ip == nil {
+			return fmt.Errorf("%s: %q is not a valid IP address", key, d.Get(key).(string))
+		}
+		if !ipNet.Contains(ip) {
+			return fmt.Errorf("%s: %s is not inside the network cidr %s", key, ip, ipNet)
+		}
+	}
+
+	poolStart := net.ParseIP(d.Get("pool_start").(string)).To4()
+	poolEnd := net.ParseIP(d.Get("pool_end").(string)).To4()
+	if bytes.Compare(poolStart, poolEnd) > 0 {
+		return fmt.Errorf("pool_start: %s must not be after pool_end %s", poolStart, poolEnd)
+	}
+
+	return nil
+}
+
 func resourceNetworkCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	networkClient := config.SandwichClient.Network()
@@ -0,0 +1,186 @@
+package sandwich
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+	"github.com/sandwichcloud/deli-cli/api/client"
+)
+
+// Ports in these states are not being worked on by the API
+var networkPortTerminalStates = []string{"Created", "Error"}
+
+func resourceNetworkPortGC() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkPortGCCreate,
+		Read:   resourceNetworkPortGCRead,
+		Delete: resourceNetworkPortGCDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"network_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"states": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateOneOf(networkPortTerminalStates...),
+				},
+				Set: schema.HashString,
+			},
+			// Instance creates allocate their port before the instance
+			// references it, so young ports are left alone
+			"min_age": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "15m",
+				ForceNew:     true,
+				ValidateFunc: validateDuration,
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_port_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkPortGCCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	d.Set("project_name", projectName)
+
+	networkName := d.Get("network_name").(string)
+	minAge, _ := time.ParseDuration(d.Get("min_age").(string))
+	states := setToStrings(d.Get("states").(*schema.Set))
+	if len(states) == 0 {
+		states = networkPortTerminalStates
+	}
+
+	networkPortClient := config.SandwichClient.NetworkPort(projectName)
+
+	instances, err := listAllInstances(config.SandwichClient.Instance(projectName))
+	if err != nil {
+		return err
+	}
+	inUse := map[string]bool{}
+	for _, instance := range instances {
+		inUse[instance.NetworkPortID.String()] = true
+	}
+
+	networkPorts, err := listAllNetworkPorts(networkPortClient)
+	if err != nil {
+		return err
+	}
+
+	deletedIDs := make([]string, 0)
+	deletedIPAddresses := make([]string, 0)
+	for _, networkPort := range networkPorts {
+		id := networkPort.ID.String()
+		if inUse[id] || !stringInSlice(networkPort.State, states) {
+			continue
+		}
+		if networkName != "" && networkPort.NetworkName != networkName {
+			continue
+		}
+		if time.Since(networkPort.CreatedAt) < minAge {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting orphaned network port %s (%s) in project %s", id, networkPort.IPAddress, projectName)
+		err := networkPortClient.Delete(id)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		deletedIDs = append(deletedIDs, id)
+		deletedIPAddresses = append(deletedIPAddresses, networkPort.IPAddress.String())
+	}
+
+	for _, id := range deletedIDs {
+		stateConf := &resource.StateChangeConf{
+			// The port can still report Created until the API picks up the delete
+			Pending:    []string{"Created", "ToDelete", "Deleting"},
+			Target:     []string{"Deleted"},
+			Refresh:    NetworkPortRefreshFunc(networkPortClient, id),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for network port (%s) to delete: %s", id, err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("deleted_port_ids", deletedIDs)
+	d.Set("deleted_ip_addresses", deletedIPAddresses)
+
+	return nil
+}
+
+func resourceNetworkPortGCRead(d *schema.ResourceData, meta interface{}) error {
+	// The collection only runs on create, there is nothing to refresh
+	return nil
+}
+
+func resourceNetworkPortGCDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func NetworkPortRefreshFunc(networkPortClient client.NetworkPortClientInterface, id string) func() (result interface{}, state string, err error) {
+	return func() (result interface{}, state string, err error) {
+		networkPort, err := networkPortClient.Get(id)
+		if err != nil {
+			if apiError, ok := err.(api.APIErrorInterface); ok {
+				if apiError.IsNotFound() {
+					return networkPort, "Deleted", nil
+				}
+			}
+			return nil, "", err
+		}
+		return networkPort, networkPort.State, nil
+	}
+}
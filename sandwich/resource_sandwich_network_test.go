@@ -0,0 +1,92 @@
+package sandwich
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceNetworkCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:    "gateway outside the cidr",
+			raw:     map[string]interface{}{"gateway": "10.0.1.1"},
+			wantErr: "gateway: 10.0.1.1 is not inside the network cidr 10.0.0.0/24",
+		},
+		{
+			name:    "pool start outside the cidr",
+			raw:     map[string]interface{}{"pool_start": "10.0.1.10"},
+			wantErr: "pool_start: 10.0.1.10 is not inside the network cidr 10.0.0.0/24",
+		},
+		{
+			name:    "pool end outside the cidr",
+			raw:     map[string]interface{}{"pool_end": "10.0.1.200"},
+			wantErr: "pool_end: 10.0.1.200 is not inside the network cidr 10.0.0.0/24",
+		},
+		{
+			name:    "pool start after pool end",
+			raw:     map[string]interface{}{"pool_start": "10.0.0.200", "pool_end": "10.0.0.10"},
+			wantErr: "pool_start: 10.0.0.200 must not be after pool_end 10.0.0.10",
+		},
+		{
+			name: "single address pool",
+			raw:  map[string]interface{}{"pool_start": "10.0.0.10", "pool_end": "10.0.0.10"},
+		},
+		{
+			name:    "invalid cidr",
+			raw:     map[string]interface{}{"cidr": "10.0.0.0/33"},
+			wantErr: "cidr:",
+		},
+		{
+			name:    "invalid gateway",
+			raw:     map[string]interface{}{"gateway": "gateway"},
+			wantErr: `gateway: "gateway" is not a valid IP address`,
+		},
+		{
+			name: "unknown cidr skips the checks",
+			raw:  map[string]interface{}{"cidr": config.UnknownVariableValue, "gateway": "192.168.0.1"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := map[string]interface{}{
+				"name":        "net-a",
+				"region_name": "region-a",
+				"port_group":  "port-group",
+				"cidr":        "10.0.0.0/24",
+				"gateway":     "10.0.0.1",
+				"pool_start":  "10.0.0.10",
+				"pool_end":    "10.0.0.200",
+				"dns_servers": []interface{}{"10.0.0.2"},
+			}
+			for k, v := range tc.raw {
+				values[k] = v
+			}
+			rawConfig, err := config.NewRawConfig(values)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = resourceNetwork().Diff(nil, terraform.NewResourceConfig(rawConfig), nil)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package sandwich
 
 import (
 	"fmt"
+	"log"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -14,19 +16,59 @@ func resourceProject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProjectCreate,
 		Read:   resourceProjectRead,
+		Update: resourceProjectUpdate,
 		Delete: resourceProjectDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"quota": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vcpu": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"disk": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			// Added to the owner binding, owners that are not listed here such as
+			// the identity that created the project are left alone
+			"owners": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMMember,
+				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -43,6 +85,47 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(project.Name)
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Deleted"},
+		Target:     []string{"Created"},
+		Refresh:    ProjectRefreshFunc(projectClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for project (%s) to become ready: %s", d.Id(), err)
+	}
+
+	if _, ok := d.GetOk("quota"); ok {
+		// The quota is created after the project reports Created
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Pending"},
+			Target:     []string{"Ready"},
+			Refresh:    ProjectQuotaRefreshFunc(projectClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      3 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for project (%s) quota to become ready: %s", d.Id(), err)
+		}
+
+		err = resourceProjectSetQuota(d, config)
+		if err != nil {
+			return err
+		}
+	}
+
+	if owners, ok := d.GetOk("owners"); ok {
+		err = resourceProjectSetOwners(d, config, expandIAMMembers(owners.(*schema.Set)), nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceProjectRead(d, meta)
 }
 
@@ -63,13 +146,121 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", project.Name)
 
+	// quota and owners are only tracked when configured so they don't fight
+	// with sandwich_iam_project_quota and the policy resources
+	if _, ok := d.GetOk("quota"); ok {
+		quota, err := projectClient.GetQuota(project.Name)
+		if err != nil {
+			return err
+		}
+		d.Set("quota", []map[string]interface{}{
+			{
+				"vcpu": quota.VCPU,
+				"ram":  quota.Ram,
+				"disk": quota.Disk,
+			},
+		})
+	}
+
+	if configured, ok := d.GetOk("owners"); ok {
+		policy, err := config.SandwichClient.ProjectPolicy(project.Name).Get()
+		if err != nil {
+			return err
+		}
+		// Only the listed owners are tracked, a missing one shows up as drift
+		owners := make([]string, 0)
+		for _, binding := range policy.Bindings {
+			if binding.Role != iamOwnerRole {
+				continue
+			}
+			for _, member := range binding.Members {
+				if configured.(*schema.Set).Contains(member) {
+					owners = append(owners, member)
+				}
+			}
+		}
+		d.Set("owners", schema.NewSet(schema.HashString, stringsToInterfaces(uniqueIAMMembers(owners))))
+	}
+
 	return nil
 }
 
+func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("quota") {
+		if _, ok := d.GetOk("quota"); ok {
+			err := resourceProjectSetQuota(d, config)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("owners") {
+		oldOwners, newOwners := d.GetChange("owners")
+		removed := oldOwners.(*schema.Set).Difference(newOwners.(*schema.Set))
+		err := resourceProjectSetOwners(d, config, expandIAMMembers(newOwners.(*schema.Set)), expandIAMMembers(removed))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceProjectRead(d, meta)
+}
+
+func resourceProjectSetQuota(d *schema.ResourceData, config *Config) error {
+	projectClient := config.SandwichClient.Project()
+	quota := d.Get("quota").([]interface{})[0].(map[string]interface{})
+
+	return projectClient.SetQuota(d.Id(), quota["vcpu"].(int), quota["ram"].(int), quota["disk"].(int))
+}
+
+// resourceProjectSetOwners adds owners to the owner binding and takes out the
+// removed ones, members added outside of the resource are kept.
+func resourceProjectSetOwners(d *schema.ResourceData, config *Config, owners, removed []string) error {
+	policyClient := config.SandwichClient.ProjectPolicy(d.Id())
+
+	return iamReadModifyWrite(iamProjectPolicyMutexKey(d.Id()), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
+		index := -1
+		binding := api.PolicyBinding{
+			Role:    iamOwnerRole,
+			Members: []string{},
+		}
+		for i, b := range policy.Bindings {
+			if b.Role == iamOwnerRole {
+				index = i
+				binding = b
+			}
+		}
+
+		members := make([]string, 0)
+		for _, member := range binding.Members {
+			if !stringInSlice(member, removed) {
+				members = append(members, member)
+			}
+		}
+		binding.Members = uniqueIAMMembers(append(members, owners...))
+
+		if index == -1 {
+			policy.Bindings = append(policy.Bindings, binding)
+		} else {
+			policy.Bindings[index] = binding
+		}
+	})
+}
+
 func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	projectClient := config.SandwichClient.Project()
 
+	if d.Get("force_destroy").(bool) {
+		err := resourceProjectEmpty(d, config)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := projectClient.Delete(d.Id())
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
@@ -98,6 +289,126 @@ func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceProjectEmpty deletes everything inside the project so the project itself can be deleted.
+// Volumes are detached before their instances go away and deleted after them.
+func resourceProjectEmpty(d *schema.ResourceData, config *Config) error {
+	projectName := d.Id()
+	// Every wait shares the delete timeout instead of each getting all of it
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+
+	instanceClient := config.SandwichClient.Instance(projectName)
+	volumeClient := config.SandwichClient.Volume(projectName)
+	imageClient := config.SandwichClient.Image(projectName)
+	keypairClient := config.SandwichClient.Keypair(projectName)
+	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(projectName)
+
+	volumes, err := listAllVolumes(volumeClient)
+	if err != nil {
+		return fmt.Errorf("Error listing volumes in project (%s): %s", projectName, err)
+	}
+	for _, volume := range volumes {
+		if volume.AttachedTo == "" {
+			continue
+		}
+		log.Printf("[DEBUG] Detaching volume %s from instance %s in project %s", volume.Name, volume.AttachedTo, projectName)
+		err := volumeClient.ActionDetach(volume.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		err = waitForState(VolumeTaskRefreshFunc(volumeClient, volume.Name), []string{"DETACHING"}, []string{"", "Deleted"}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("Error waiting for volume (%s) to detach: %s", volume.Name, err)
+		}
+	}
+
+	instances, err := listAllInstances(instanceClient)
+	if err != nil {
+		return fmt.Errorf("Error listing instances in project (%s): %s", projectName, err)
+	}
+	for _, instance := range instances {
+		log.Printf("[DEBUG] Deleting instance %s in project %s", instance.Name, projectName)
+		err := instanceClient.Delete(instance.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	for _, instance := range instances {
+		err := waitForState(InstanceRefreshFunc(instanceClient, instance.Name), []string{"ToDelete", "Deleting"}, []string{"Deleted"}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to delete: %s", instance.Name, err)
+		}
+	}
+
+	// Instances can take their auto delete volumes with them
+	volumes, err = listAllVolumes(volumeClient)
+	if err != nil {
+		return fmt.Errorf("Error listing volumes in project (%s): %s", projectName, err)
+	}
+	for _, volume := range volumes {
+		log.Printf("[DEBUG] Deleting volume %s in project %s", volume.Name, projectName)
+		err := volumeClient.Delete(volume.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	for _, volume := range volumes {
+		err := waitForState(VolumeStateRefreshFunc(volumeClient, volume.Name), []string{"ToDelete", "Deleting"}, []string{"Deleted"}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("Error waiting for volume (%s) to delete: %s", volume.Name, err)
+		}
+	}
+
+	images, err := listAllImages(imageClient)
+	if err != nil {
+		return fmt.Errorf("Error listing images in project (%s): %s", projectName, err)
+	}
+	for _, image := range images {
+		log.Printf("[DEBUG] Deleting image %s in project %s", image.Name, projectName)
+		err := imageClient.Delete(image.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	for _, image := range images {
+		err := waitForState(ImageRefreshFunc(imageClient, image.Name), []string{"ToDelete", "Deleting"}, []string{"Deleted"}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("Error waiting for image (%s) to delete: %s", image.Name, err)
+		}
+	}
+
+	keypairs, err := listAllKeypairs(keypairClient)
+	if err != nil {
+		return fmt.Errorf("Error listing keypairs in project (%s): %s", projectName, err)
+	}
+	for _, keypair := range keypairs {
+		log.Printf("[DEBUG] Deleting keypair %s in project %s", keypair.Name, projectName)
+		err := keypairClient.Delete(keypair.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	serviceAccounts, err := listAllServiceAccounts(serviceAccountClient)
+	if err != nil {
+		return fmt.Errorf("Error listing service accounts in project (%s): %s", projectName, err)
+	}
+	for _, serviceAccount := range serviceAccounts {
+		log.Printf("[DEBUG] Deleting service account %s in project %s", serviceAccount.Name, projectName)
+		err := serviceAccountClient.Delete(serviceAccount.Name)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	for _, serviceAccount := range serviceAccounts {
+		err := waitForState(SerivceAccountRefreshFunc(serviceAccountClient, serviceAccount.Name), []string{"ToDelete", "Deleting"}, []string{"Deleted"}, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("Error waiting for service account (%s) to delete: %s", serviceAccount.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func ProjectRefreshFunc(projectClient client.ProjectClientInterface, projectName string) func() (result interface{}, state string, err error) {
 	return func() (result interface{}, state string, err error) {
 		project, err := projectClient.Get(projectName)
@@ -112,3 +423,18 @@ func ProjectRefreshFunc(projectClient client.ProjectClientInterface, projectName
 		return project, "Created", nil
 	}
 }
+
+func ProjectQuotaRefreshFunc(projectClient client.ProjectClientInterface, projectName string) func() (result interface{}, state string, err error) {
+	return func() (result interface{}, state string, err error) {
+		quota, err := projectClient.GetQuota(projectName)
+		if err != nil {
+			if apiError, ok := err.(api.APIError); ok {
+				if apiError.StatusCode == http.StatusNotFound || apiError.StatusCode == http.StatusConflict {
+					return 0, "Pending", nil
+				}
+			}
+			return nil, "", err
+		}
+		return quota, "Ready", nil
+	}
+}
@@ -39,11 +39,44 @@ func resourceProjectPolicy() *schema.Resource {
 							Required: true,
 						},
 						"members": {
-							Type:     schema.TypeList,
+							Type:     schema.TypeSet,
 							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIAMMember,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "restore",
+				ValidateFunc: validateOneOf("restore", "clear"),
+			},
+			// Set once restore_binding holds a snapshot, which may legitimately be empty
+			"restore_recorded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"restore_binding": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Computed: true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
+							Set: schema.HashString,
 						},
 					},
 				},
@@ -59,8 +92,21 @@ func resourceProjectPolicyCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.Set("project_name", projectName)
+	policyClient := config.SandwichClient.ProjectPolicy(projectName)
+
+	var restoreBindings []api.PolicyBinding
+	err = iamReadModifyWrite(iamProjectPolicyMutexKey(projectName), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
+		restoreBindings = policy.Bindings
+		policy.Bindings = expandIAMBindings(d.Get("binding").([]interface{}))
+	})
+	if err != nil {
+		return err
+	}
+
 	d.SetId(projectName)
-	return resourceProjectPolicyUpdate(d, meta)
+	d.Set("restore_binding", flattenIAMBindings(restoreBindings))
+	d.Set("restore_recorded", true)
+	return resourceProjectPolicyRead(d, meta)
 }
 
 func resourceProjectPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -72,15 +118,7 @@ func resourceProjectPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	bindings := make([]map[string]interface{}, 0)
-	for _, binding := range policy.Bindings {
-		bindings = append(bindings, map[string]interface{}{
-			"role":    binding.Role,
-			"members": binding.Members,
-		})
-	}
-
-	d.Set("binding", bindings)
+	d.Set("binding", flattenIAMBindings(policy.Bindings))
 
 	return nil
 }
@@ -89,22 +127,8 @@ func resourceProjectPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 	config := meta.(*Config)
 	policyClient := config.SandwichClient.ProjectPolicy(d.Id())
 
-	err := iamReadModifyWrite(d.Id(), policyClient, func(policy *api.Policy) {
-		newBindings := make([]api.PolicyBinding, 0)
-
-		bindings := d.Get("binding").([]interface{})
-		for _, b := range bindings {
-			binding := b.(map[string]interface{})
-			newBinding := api.PolicyBinding{
-				Role:    binding["role"].(string),
-				Members: []string{},
-			}
-			for _, member := range binding["members"].([]interface{}) {
-				newBinding.Members = append(newBinding.Members, member.(string))
-			}
-			newBindings = append(newBindings, newBinding)
-		}
-		policy.Bindings = newBindings
+	err := iamReadModifyWrite(iamProjectPolicyMutexKey(d.Id()), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
+		policy.Bindings = expandIAMBindings(d.Get("binding").([]interface{}))
 	})
 	if err != nil {
 		return err
@@ -114,6 +138,14 @@ func resourceProjectPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceProjectPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	policyClient := config.SandwichClient.ProjectPolicy(d.Id())
+
+	err := iamDestroyPolicy(d, iamProjectPolicyMutexKey(d.Id()), policyClient, config.PolicyConflictRetries)
+	if err != nil {
+		return err
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -35,11 +35,13 @@ func resourceProjectPolicyBinding() *schema.Resource {
 				ForceNew: true,
 			},
 			"members": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMMember,
 				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -73,7 +75,7 @@ func resourceProjectPolicyBindingRead(d *schema.ResourceData, meta interface{})
 	for i, binding := range policy.Bindings {
 		if binding.Role == role {
 			index = i
-			d.Set("members", binding.Members)
+			d.Set("members", uniqueIAMMembers(binding.Members))
 			break
 		}
 	}
@@ -91,7 +93,7 @@ func resourceProjectPolicyBindingUpdate(d *schema.ResourceData, meta interface{}
 	role := strings.Split(d.Id(), "/")[1]
 	policyClient := config.SandwichClient.ProjectPolicy(projectName)
 
-	err := iamReadModifyWrite(projectName, policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamProjectPolicyMutexKey(projectName), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		index := -1
 
 		for i, binding := range policy.Bindings {
@@ -103,10 +105,7 @@ func resourceProjectPolicyBindingUpdate(d *schema.ResourceData, meta interface{}
 
 		binding := api.PolicyBinding{
 			Role:    role,
-			Members: make([]string, 0),
-		}
-		for _, member := range d.Get("members").([]interface{}) {
-			binding.Members = append(binding.Members, member.(string))
+			Members: expandIAMMembers(d.Get("members").(*schema.Set)),
 		}
 
 		if index == -1 {
@@ -128,7 +127,7 @@ func resourceProjectPolicyBindingDelete(d *schema.ResourceData, meta interface{}
 	role := strings.Split(d.Id(), "/")[1]
 	policyClient := config.SandwichClient.ProjectPolicy(projectName)
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamProjectPolicyMutexKey(projectName), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		for i, binding := range policy.Bindings {
 			if binding.Role == role {
 				policy.Bindings = append(policy.Bindings[:i], policy.Bindings[i+1:]...)
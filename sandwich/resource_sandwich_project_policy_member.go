@@ -34,9 +34,10 @@ func resourceProjectPolicyMember() *schema.Resource {
 				ForceNew: true,
 			},
 			"member": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIAMMember,
 			},
 		},
 	}
@@ -55,7 +56,7 @@ func resourceProjectPolicyMemberCreate(d *schema.ResourceData, meta interface{})
 	role := d.Get("role").(string)
 	member := d.Get("member").(string)
 
-	err = iamReadModifyWrite(projectName, policyClient, func(policy *api.Policy) {
+	err = iamReadModifyWrite(iamProjectPolicyMutexKey(projectName), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		index := -1
 		binding := api.PolicyBinding{
 			Role:    role,
@@ -67,7 +68,7 @@ func resourceProjectPolicyMemberCreate(d *schema.ResourceData, meta interface{})
 				binding = b
 			}
 		}
-		binding.Members = append(binding.Members, member)
+		binding.Members = uniqueIAMMembers(append(binding.Members, member))
 
 		if index == -1 {
 			policy.Bindings = append(policy.Bindings, binding)
@@ -124,15 +125,16 @@ func resourceProjectPolicyMemberDelete(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 	policyClient := config.SandwichClient.ProjectPolicy(projectName)
 
-	err := iamReadModifyWrite(projectName, policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamProjectPolicyMutexKey(projectName), policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		for i, binding := range policy.Bindings {
 			if binding.Role == role {
-				for j, m := range binding.Members {
-					if member == m {
-						binding.Members = append(binding.Members[:j], binding.Members[j+1:]...)
-						break
+				members := make([]string, 0)
+				for _, m := range binding.Members {
+					if member != m {
+						members = append(members, m)
 					}
 				}
+				binding.Members = members
 				policy.Bindings[i] = binding
 				break
 			}
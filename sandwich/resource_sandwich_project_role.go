@@ -16,6 +16,8 @@ func resourceProjectRole() *schema.Resource {
 		Update: resourceProjectRoleUpdate,
 		Delete: resourceProjectRoleDelete,
 
+		CustomizeDiff: resourceRoleCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -25,9 +27,10 @@ func resourceProjectRole() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"project_name": {
 				Type:     schema.TypeString,
@@ -36,11 +39,12 @@ func resourceProjectRole() *schema.Resource {
 				ForceNew: true,
 			},
 			"permissions": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -58,8 +62,8 @@ func resourceProjectRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	d.Set("project_name", projectName)
 
 	var permissions []string
-	for _, policyInt := range d.Get("permissions").([]interface{}) {
-		permissions = append(permissions, policyInt.(string))
+	for _, permission := range d.Get("permissions").(*schema.Set).List() {
+		permissions = append(permissions, permission.(string))
 	}
 
 	role, err := roleClient.Create(name, permissions)
@@ -110,12 +114,12 @@ func resourceProjectRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	roleClient := config.SandwichClient.ProjectRole(d.Get("project_name").(string))
 
-	var policies []string
-	for _, policyInt := range d.Get("policies").([]interface{}) {
-		policies = append(policies, policyInt.(string))
+	var permissions []string
+	for _, permission := range d.Get("permissions").(*schema.Set).List() {
+		permissions = append(permissions, permission.(string))
 	}
 
-	err := roleClient.Update(d.Id(), policies)
+	err := roleClient.Update(d.Id(), permissions)
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
 			if apiError.IsNotFound() {
@@ -2,6 +2,7 @@ package sandwich
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -15,6 +16,10 @@ func resourceProjectServiceAccount() *schema.Resource {
 		Read:   resourceProjectServiceAccountRead,
 		Delete: resourceProjectServiceAccountDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceProjectServiceAccountImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -23,14 +28,30 @@ func resourceProjectServiceAccount() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"email": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"project_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,10 +115,26 @@ func resourceProjectServiceAccountRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("name", serviceAccount.Name)
+	d.Set("email", serviceAccount.Email)
+	d.Set("state", serviceAccount.State)
+	d.Set("keys", serviceAccount.Keys)
+	d.Set("created_at", serviceAccount.CreatedAt.Format(time.RFC3339))
 
 	return nil
 }
 
+func resourceProjectServiceAccountImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid project service account id %q, expected {project_name}/{name}", d.Id())
+	}
+
+	d.Set("project_name", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceProjectServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(d.Get("project_name").(string))
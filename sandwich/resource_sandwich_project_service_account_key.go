@@ -0,0 +1,164 @@
+package sandwich
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func resourceProjectServiceAccountKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectServiceAccountKeyCreate,
+		Read:   resourceProjectServiceAccountKeyRead,
+		Update: resourceProjectServiceAccountKeyUpdate,
+		Delete: resourceProjectServiceAccountKeyDelete,
+
+		CustomizeDiff: resourceServiceAccountKeyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceProjectServiceAccountKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(projectName)
+
+	name := d.Get("name").(string)
+	serviceAccountName := d.Get("service_account_name").(string)
+
+	d.Set("project_name", projectName)
+
+	token, err := serviceAccountClient.CreateKey(serviceAccountName, name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(projectName + "/" + serviceAccountName + "/" + name)
+	setServiceAccountKeyToken(d, token)
+
+	return resourceProjectServiceAccountKeyRead(d, meta)
+}
+
+func resourceProjectServiceAccountKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName := strings.Split(d.Id(), "/")[0]
+	serviceAccountName := strings.Split(d.Id(), "/")[1]
+	name := strings.Split(d.Id(), "/")[2]
+	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(projectName)
+
+	serviceAccount, err := serviceAccountClient.Get(serviceAccountName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if !serviceAccountHasKey(serviceAccount, name) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("project_name", projectName)
+	d.Set("service_account_name", serviceAccountName)
+
+	return nil
+}
+
+func resourceProjectServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only rotation_days can change in place and it is checked when planning
+	return resourceProjectServiceAccountKeyRead(d, meta)
+}
+
+func resourceProjectServiceAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName := strings.Split(d.Id(), "/")[0]
+	serviceAccountName := strings.Split(d.Id(), "/")[1]
+	name := strings.Split(d.Id(), "/")[2]
+	serviceAccountClient := config.SandwichClient.ProjectServiceAccount(projectName)
+
+	err := serviceAccountClient.DeleteKey(serviceAccountName, name)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -17,6 +17,8 @@ func resourceRegion() *schema.Resource {
 		Update: resourceRegionUpdate,
 		Delete: resourceRegionDelete,
 
+		CustomizeDiff: resourceRegionCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -26,9 +28,10 @@ func resourceRegion() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"datacenter": {
 				Type:     schema.TypeString,
@@ -51,6 +54,11 @@ func resourceRegion() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"drain_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -138,6 +146,17 @@ func resourceRegionDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.Get("drain_on_destroy").(bool) {
+		zoneNames, err := regionZoneNames(config, d.Id())
+		if err != nil {
+			return err
+		}
+		err = drainZones(config, zoneNames, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
 	err = regionClient.Delete(d.Id())
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
@@ -166,6 +185,34 @@ func resourceRegionDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceRegionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.Id() == "" || !d.HasChange("schedulable") || d.Get("schedulable").(bool) {
+		return nil
+	}
+
+	zoneNames, err := regionZoneNames(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	return warnUnscheduleWorkloads(config, "Region", d.Id(), zoneNames)
+}
+
+func regionZoneNames(config *Config, regionName string) (map[string]bool, error) {
+	zones, err := listAllZones(config.SandwichClient.Zone(), regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneNames := map[string]bool{}
+	for _, zone := range zones {
+		zoneNames[zone.Name] = true
+	}
+	return zoneNames, nil
+}
+
 func RegionRefreshFunc(regionClient client.RegionClientInterface, regionName string) func() (result interface{}, state string, err error) {
 	return func() (result interface{}, state string, err error) {
 		region, err := regionClient.Get(regionName)
@@ -34,11 +34,46 @@ func resourceSystemPolicy() *schema.Resource {
 							Required: true,
 						},
 						"members": {
-							Type:     schema.TypeList,
+							Type:     schema.TypeSet,
 							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIAMMember,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			// Clearing the system policy would also remove the bindings operators
+			// need to reach the API, so it can only be restored
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "restore",
+				ValidateFunc: validateOneOf("restore"),
+			},
+			// Set once restore_binding holds a snapshot, which may legitimately be empty
+			"restore_recorded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"restore_binding": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Computed: true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
+							Set: schema.HashString,
 						},
 					},
 				},
@@ -48,12 +83,27 @@ func resourceSystemPolicy() *schema.Resource {
 }
 
 func resourceSystemPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	policyClient := config.SandwichClient.SystemPolicy()
+
 	id, err := uuid.GenerateUUID()
 	if err != nil {
 		return err
 	}
+
+	var restoreBindings []api.PolicyBinding
+	err = iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
+		restoreBindings = policy.Bindings
+		policy.Bindings = expandIAMBindings(d.Get("binding").([]interface{}))
+	})
+	if err != nil {
+		return err
+	}
+
 	d.SetId(id)
-	return resourceSystemPolicyUpdate(d, meta)
+	d.Set("restore_binding", flattenIAMBindings(restoreBindings))
+	d.Set("restore_recorded", true)
+	return resourceSystemPolicyRead(d, meta)
 }
 
 func resourceSystemPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -65,15 +115,7 @@ func resourceSystemPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	bindings := make([]map[string]interface{}, 0)
-	for _, binding := range policy.Bindings {
-		bindings = append(bindings, map[string]interface{}{
-			"role":    binding.Role,
-			"members": binding.Members,
-		})
-	}
-
-	d.Set("binding", bindings)
+	d.Set("binding", flattenIAMBindings(policy.Bindings))
 
 	return nil
 }
@@ -82,22 +124,8 @@ func resourceSystemPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 	config := meta.(*Config)
 	policyClient := config.SandwichClient.SystemPolicy()
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
-		newBindings := make([]api.PolicyBinding, 0)
-
-		bindings := d.Get("binding").([]interface{})
-		for _, b := range bindings {
-			binding := b.(map[string]interface{})
-			newBinding := api.PolicyBinding{
-				Role:    binding["role"].(string),
-				Members: []string{},
-			}
-			for _, member := range binding["members"].([]interface{}) {
-				newBinding.Members = append(newBinding.Members, member.(string))
-			}
-			newBindings = append(newBindings, newBinding)
-		}
-		policy.Bindings = newBindings
+	err := iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
+		policy.Bindings = expandIAMBindings(d.Get("binding").([]interface{}))
 	})
 	if err != nil {
 		return err
@@ -107,6 +135,14 @@ func resourceSystemPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSystemPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	policyClient := config.SandwichClient.SystemPolicy()
+
+	err := iamDestroyPolicy(d, iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries)
+	if err != nil {
+		return err
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -28,11 +28,13 @@ func resourceSystemPolicyBinding() *schema.Resource {
 				ForceNew: true,
 			},
 			"members": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateIAMMember,
 				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -59,7 +61,7 @@ func resourceSystemPolicyBindingRead(d *schema.ResourceData, meta interface{}) e
 	for i, binding := range policy.Bindings {
 		if binding.Role == role {
 			index = i
-			d.Set("members", binding.Members)
+			d.Set("members", uniqueIAMMembers(binding.Members))
 			break
 		}
 	}
@@ -75,7 +77,7 @@ func resourceSystemPolicyBindingUpdate(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 	policyClient := config.SandwichClient.SystemPolicy()
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		index := -1
 
 		for i, binding := range policy.Bindings {
@@ -87,10 +89,7 @@ func resourceSystemPolicyBindingUpdate(d *schema.ResourceData, meta interface{})
 
 		binding := api.PolicyBinding{
 			Role:    d.Get("role").(string),
-			Members: make([]string, 0),
-		}
-		for _, member := range d.Get("members").([]interface{}) {
-			binding.Members = append(binding.Members, member.(string))
+			Members: expandIAMMembers(d.Get("members").(*schema.Set)),
 		}
 
 		if index == -1 {
@@ -110,7 +109,7 @@ func resourceSystemPolicyBindingDelete(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 	policyClient := config.SandwichClient.SystemPolicy()
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		for i, binding := range policy.Bindings {
 			if binding.Role == d.Id() {
 				policy.Bindings = append(policy.Bindings[:i], policy.Bindings[i+1:]...)
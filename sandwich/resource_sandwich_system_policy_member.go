@@ -28,9 +28,10 @@ func resourceSystemPolicyMember() *schema.Resource {
 				ForceNew: true,
 			},
 			"member": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIAMMember,
 			},
 		},
 	}
@@ -43,7 +44,7 @@ func resourceSystemPolicyMemberCreate(d *schema.ResourceData, meta interface{})
 	role := d.Get("role").(string)
 	member := d.Get("member").(string)
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		index := -1
 		binding := api.PolicyBinding{
 			Role:    role,
@@ -55,7 +56,7 @@ func resourceSystemPolicyMemberCreate(d *schema.ResourceData, meta interface{})
 				binding = b
 			}
 		}
-		binding.Members = append(binding.Members, member)
+		binding.Members = uniqueIAMMembers(append(binding.Members, member))
 
 		if index == -1 {
 			policy.Bindings = append(policy.Bindings, binding)
@@ -110,15 +111,16 @@ func resourceSystemPolicyMemberDelete(d *schema.ResourceData, meta interface{})
 	role := strings.Split(d.Id(), "/")[0]
 	member := strings.Split(d.Id(), "/")[1]
 
-	err := iamReadModifyWrite("system", policyClient, func(policy *api.Policy) {
+	err := iamReadModifyWrite(iamSystemPolicyMutexKey, policyClient, config.PolicyConflictRetries, func(policy *api.Policy) {
 		for i, binding := range policy.Bindings {
 			if binding.Role == role {
-				for j, m := range binding.Members {
-					if member == m {
-						binding.Members = append(binding.Members[:j], binding.Members[j+1:]...)
-						break
+				members := make([]string, 0)
+				for _, m := range binding.Members {
+					if member != m {
+						members = append(members, m)
 					}
 				}
+				binding.Members = members
 				policy.Bindings[i] = binding
 				break
 			}
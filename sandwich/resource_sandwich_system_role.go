@@ -17,6 +17,8 @@ func resourceSystemRole() *schema.Resource {
 		Update: resourceSystemRoleUpdate,
 		Delete: resourceSystemRoleDelete,
 
+		CustomizeDiff: resourceRoleCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -26,16 +28,18 @@ func resourceSystemRole() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"permissions": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Required: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				Set: schema.HashString,
 			},
 		},
 	}
@@ -48,8 +52,8 @@ func resourceSystemRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 
 	var permissions []string
-	for _, policyInt := range d.Get("permissions").([]interface{}) {
-		permissions = append(permissions, policyInt.(string))
+	for _, permission := range d.Get("permissions").(*schema.Set).List() {
+		permissions = append(permissions, permission.(string))
 	}
 
 	role, err := roleClient.Create(name, permissions)
@@ -100,12 +104,12 @@ func resourceSystemRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	roleClient := config.SandwichClient.SystemRole()
 
-	var policies []string
-	for _, policyInt := range d.Get("policies").([]interface{}) {
-		policies = append(policies, policyInt.(string))
+	var permissions []string
+	for _, permission := range d.Get("permissions").(*schema.Set).List() {
+		permissions = append(permissions, permission.(string))
 	}
 
-	err := roleClient.Update(d.Id(), policies)
+	err := roleClient.Update(d.Id(), permissions)
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
 			if apiError.IsNotFound() {
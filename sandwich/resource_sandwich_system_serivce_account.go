@@ -16,6 +16,10 @@ func resourceSystemServiceAccount() *schema.Resource {
 		Read:   resourceSystemServiceAccountRead,
 		Delete: resourceSystemServiceAccountDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -24,14 +28,30 @@ func resourceSystemServiceAccount() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"email": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -83,6 +103,10 @@ func resourceSystemServiceAccountRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("name", serviceAccount.Name)
+	d.Set("email", serviceAccount.Email)
+	d.Set("state", serviceAccount.State)
+	d.Set("keys", serviceAccount.Keys)
+	d.Set("created_at", serviceAccount.CreatedAt.Format(time.RFC3339))
 
 	return nil
 }
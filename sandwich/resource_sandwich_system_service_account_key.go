@@ -0,0 +1,187 @@
+package sandwich
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+	"golang.org/x/oauth2"
+)
+
+func resourceSystemServiceAccountKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSystemServiceAccountKeyCreate,
+		Read:   resourceSystemServiceAccountKeyRead,
+		Update: resourceSystemServiceAccountKeyUpdate,
+		Delete: resourceSystemServiceAccountKeyDelete,
+
+		CustomizeDiff: resourceServiceAccountKeyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"service_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSystemServiceAccountKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceAccountClient := config.SandwichClient.SystemServiceAccount()
+
+	name := d.Get("name").(string)
+	serviceAccountName := d.Get("service_account_name").(string)
+
+	token, err := serviceAccountClient.CreateKey(serviceAccountName, name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceAccountName + "/" + name)
+	setServiceAccountKeyToken(d, token)
+
+	return resourceSystemServiceAccountKeyRead(d, meta)
+}
+
+func resourceSystemServiceAccountKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceAccountName := strings.Split(d.Id(), "/")[0]
+	name := strings.Split(d.Id(), "/")[1]
+	serviceAccountClient := config.SandwichClient.SystemServiceAccount()
+
+	serviceAccount, err := serviceAccountClient.Get(serviceAccountName)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if !serviceAccountHasKey(serviceAccount, name) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("service_account_name", serviceAccountName)
+
+	return nil
+}
+
+func resourceSystemServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only rotation_days can change in place and it is checked when planning
+	return resourceSystemServiceAccountKeyRead(d, meta)
+}
+
+func resourceSystemServiceAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceAccountName := strings.Split(d.Id(), "/")[0]
+	name := strings.Split(d.Id(), "/")[1]
+	serviceAccountClient := config.SandwichClient.SystemServiceAccount()
+
+	err := serviceAccountClient.DeleteKey(serviceAccountName, name)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceAccountKeyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	rotationDays := d.Get("rotation_days").(int)
+	createdAt := d.Get("created_at").(string)
+	if d.Id() == "" || rotationDays <= 0 || createdAt == "" {
+		return nil
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return err
+	}
+
+	if time.Since(created) >= time.Duration(rotationDays)*24*time.Hour {
+		return d.SetNewComputed("created_at")
+	}
+	return nil
+}
+
+func setServiceAccountKeyToken(d *schema.ResourceData, token *oauth2.Token) {
+	d.Set("access_token", token.AccessToken)
+	d.Set("refresh_token", token.RefreshToken)
+	d.Set("token_type", token.TokenType)
+	if !token.Expiry.IsZero() {
+		d.Set("expiry", token.Expiry.UTC().Format(time.RFC3339))
+	}
+	d.Set("created_at", time.Now().UTC().Format(time.RFC3339))
+}
+
+func serviceAccountHasKey(serviceAccount *api.ServiceAccount, keyName string) bool {
+	for _, key := range serviceAccount.Keys {
+		if key == keyName {
+			return true
+		}
+	}
+	return false
+}
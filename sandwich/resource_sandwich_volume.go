@@ -2,6 +2,7 @@ package sandwich
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -17,6 +18,8 @@ func resourceVolume() *schema.Resource {
 		Update: resourceVolumeUpdate,
 		Delete: resourceVolumeDelete,
 
+		CustomizeDiff: resourceVolumeCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -26,9 +29,10 @@ func resourceVolume() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"project_name": {
 				Type:     schema.TypeString,
@@ -50,7 +54,7 @@ func resourceVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: false,
 				Optional: true,
-				ForceNew: false,
+				ForceNew: true,
 			},
 			"attached_to": {
 				Type:     schema.TypeString,
@@ -58,6 +62,27 @@ func resourceVolume() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"allow_shrink_by_replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"detach_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"final_clone_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateName,
+			},
+			// Set once a destroy made the final clone, so a retried destroy can tell
+			// its own clone from an unrelated volume with the same name
+			"final_clone_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -76,6 +101,9 @@ func resourceVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 	clonedFrom := d.Get("cloned_from").(string)
 	d.Set("project_name", projectName)
 
+	release := config.createLimiter.Acquire(zoneName)
+	defer release()
+
 	if len(clonedFrom) == 0 {
 		volume, err := volumeClient.Create(name, zoneName, size)
 		if err != nil {
@@ -234,26 +262,72 @@ func resourceVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	volumeClient := config.SandwichClient.Volume(d.Get("project_name").(string))
 
-	err := volumeClient.ActionDetach(d.Id())
+	volume, err := volumeClient.Get(d.Id())
 	if err != nil {
-		if apiError, ok := err.(api.APIError); ok {
-			if apiError.StatusCode != 409 {
-				return err
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
 			}
 		}
 		return err
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"DETACHING"},
-		Target:     []string{""},
-		Refresh:    VolumeTaskRefreshFunc(volumeClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+
+	if volume.AttachedTo != "" {
+		if !d.Get("detach_on_destroy").(bool) {
+			return fmt.Errorf("volume %s is attached to instance %s and detach_on_destroy is false, detach it before destroying", d.Id(), volume.AttachedTo)
+		}
+
+		err := volumeClient.ActionDetach(d.Id())
+		if err != nil {
+			if apiError, ok := err.(api.APIError); !ok || apiError.StatusCode != 409 {
+				return err
+			}
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"DETACHING"},
+			Target:     []string{""},
+			Refresh:    VolumeTaskRefreshFunc(volumeClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for volume (%s) to detach: %s", d.Id(), err)
+		}
 	}
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for volume (%s) to detach: %s", d.Id(), err)
+
+	if finalCloneName := d.Get("final_clone_name").(string); finalCloneName != "" {
+		existing, err := volumeClient.Get(finalCloneName)
+		if err != nil {
+			if !isNotFoundError(err) {
+				return err
+			}
+			clone, err := volumeClient.ActionClone(d.Id(), finalCloneName)
+			if err != nil {
+				return fmt.Errorf("Error creating final clone (%s) of volume (%s): %s", finalCloneName, d.Id(), err)
+			}
+			d.Set("final_clone_created_at", clone.CreatedAt.Format(time.RFC3339))
+		} else if existing.CreatedAt.Format(time.RFC3339) != d.Get("final_clone_created_at").(string) {
+			return fmt.Errorf("Error creating final clone (%s) of volume (%s): a volume with that name already exists "+
+				"and was not cloned by this destroy, delete or rename it, or change final_clone_name", finalCloneName, d.Id())
+		} else {
+			// A destroy that failed after cloning already left the clone behind
+			log.Printf("[DEBUG] Reusing final clone %s of volume %s from an earlier destroy", finalCloneName, d.Id())
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"ToCreate", "Creating"},
+			Target:     []string{"Created"},
+			Refresh:    VolumeStateRefreshFunc(volumeClient, finalCloneName),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for final clone (%s) of volume (%s) to become ready: %s", finalCloneName, d.Id(), err)
+		}
 	}
 
 	err = volumeClient.Delete(d.Id())
@@ -261,11 +335,11 @@ func resourceVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	stateConf = &resource.StateChangeConf{
+	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ToDelete", "Deleting"},
 		Target:     []string{"Deleted"},
 		Refresh:    VolumeStateRefreshFunc(volumeClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -279,6 +353,57 @@ func resourceVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceVolumeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	// The API can only grow volumes
+	if d.Id() != "" && d.HasChange("size") {
+		oldSize, newSize := d.GetChange("size")
+		if newSize.(int) < oldSize.(int) {
+			if !d.Get("allow_shrink_by_replace").(bool) {
+				return fmt.Errorf("volume %s cannot shrink from %d to %d, set allow_shrink_by_replace to recreate it with the new size", d.Id(), oldSize, newSize)
+			}
+			err := d.ForceNew("size")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.HasChange("attached_to") && !d.HasChange("zone_name") {
+		return nil
+	}
+	if !d.NewValueKnown("attached_to") || !d.NewValueKnown("zone_name") {
+		return nil
+	}
+	attachedTo := d.Get("attached_to").(string)
+	zoneName := d.Get("zone_name").(string)
+	if attachedTo == "" || zoneName == "" {
+		return nil
+	}
+
+	projectName := d.Get("project_name").(string)
+	if projectName == "" {
+		projectName = config.ProjectName
+	}
+
+	instance, err := config.SandwichClient.Instance(projectName).Get(attachedTo)
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return nil
+			}
+		}
+		return err
+	}
+
+	if instance.ZoneName != zoneName {
+		return fmt.Errorf("volume in zone %s cannot be attached to instance %s in zone %s", zoneName, attachedTo, instance.ZoneName)
+	}
+
+	return nil
+}
+
 func VolumeStateRefreshFunc(volumeClient client.VolumeClientInterface, volumeName string) func() (result interface{}, state string, err error) {
 	return func() (result interface{}, state string, err error) {
 		volume, err := volumeClient.Get(volumeName)
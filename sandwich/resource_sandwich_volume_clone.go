@@ -0,0 +1,205 @@
+package sandwich
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+func resourceVolumeClone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVolumeCloneCreate,
+		Read:   resourceVolumeCloneRead,
+		Delete: resourceVolumeCloneDelete,
+
+		CustomizeDiff: resourceVolumeCloneCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_volume": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVolumeCloneCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	projectName, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	volumeClient := config.SandwichClient.Volume(projectName)
+	name := d.Get("name").(string)
+	sourceVolume := d.Get("source_volume").(string)
+	d.Set("project_name", projectName)
+
+	source, err := volumeClient.Get(sourceVolume)
+	if err != nil {
+		return err
+	}
+
+	release := config.createLimiter.Acquire(source.ZoneName)
+	defer release()
+
+	volume, err := volumeClient.ActionClone(source.Name, name)
+	if err != nil {
+		return err
+	}
+	d.SetId(volume.Name)
+	d.Set("source_size", source.Size)
+	d.Set("source_created_at", source.CreatedAt.Format(time.RFC3339))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ToCreate", "Creating"},
+		Target:     []string{"Created"},
+		Refresh:    VolumeStateRefreshFunc(volumeClient, volume.Name),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for volume clone (%s) to become ready: %s", volume.Name, err)
+	}
+
+	return resourceVolumeCloneRead(d, meta)
+}
+
+func resourceVolumeCloneRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	volumeClient := config.SandwichClient.Volume(d.Get("project_name").(string))
+
+	volume, err := volumeClient.Get(d.Id())
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("zone_name", volume.ZoneName)
+	d.Set("size", volume.Size)
+	d.Set("created_at", volume.CreatedAt.Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceVolumeCloneDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	volumeClient := config.SandwichClient.Volume(d.Get("project_name").(string))
+
+	err := volumeClient.Delete(d.Id())
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ToDelete", "Deleting"},
+		Target:     []string{"Deleted"},
+		Refresh:    VolumeStateRefreshFunc(volumeClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for volume clone (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// A source volume that was deleted and recreated under the same name is a
+// different volume, so the clone is replaced to pick up its data.
+func resourceVolumeCloneCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.Id() == "" || d.HasChange("source_volume") {
+		return nil
+	}
+
+	projectName := d.Get("project_name").(string)
+	if projectName == "" {
+		projectName = config.ProjectName
+	}
+
+	source, err := config.SandwichClient.Volume(projectName).Get(d.Get("source_volume").(string))
+	if err != nil {
+		if apiError, ok := err.(api.APIErrorInterface); ok {
+			if apiError.IsNotFound() {
+				return nil
+			}
+		}
+		return err
+	}
+
+	if source.CreatedAt.Format(time.RFC3339) != d.Get("source_created_at").(string) {
+		return d.SetNewComputed("source_created_at")
+	}
+
+	return nil
+}
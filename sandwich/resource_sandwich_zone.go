@@ -17,6 +17,8 @@ func resourceZone() *schema.Resource {
 		Update: resourceZoneUpdate,
 		Delete: resourceZoneDelete,
 
+		CustomizeDiff: resourceZoneCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -26,9 +28,10 @@ func resourceZone() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
 			},
 			"region_name": {
 				Type:     schema.TypeString,
@@ -52,22 +55,27 @@ func resourceZone() *schema.Resource {
 				ForceNew: true,
 			},
 			"core_provision_percent": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1600,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1600,
+				ValidateFunc: validateProvisionPercent,
 			},
 			"ram_provision_percent": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  150,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      150,
+				ValidateFunc: validateProvisionPercent,
 			},
 			"schedulable": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"drain_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -137,6 +145,13 @@ func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	zoneClient := config.SandwichClient.Zone()
 
+	if !d.IsNewResource() && (d.HasChange("core_provision_percent") || d.HasChange("ram_provision_percent")) {
+		_, err := zoneClient.Update(d.Id(), d.Get("core_provision_percent").(int), d.Get("ram_provision_percent").(int))
+		if err != nil {
+			return err
+		}
+	}
+
 	err := zoneClient.ActionSchedule(d.Id(), d.Get("schedulable").(bool))
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
@@ -160,6 +175,13 @@ func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.Get("drain_on_destroy").(bool) {
+		err = drainZones(config, map[string]bool{d.Id(): true}, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return err
+		}
+	}
+
 	err = zoneClient.Delete(d.Id())
 	if err != nil {
 		if apiError, ok := err.(api.APIErrorInterface); ok {
@@ -188,6 +210,16 @@ func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceZoneCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.Id() == "" || !d.HasChange("schedulable") || d.Get("schedulable").(bool) {
+		return nil
+	}
+
+	return warnUnscheduleWorkloads(config, "Zone", d.Id(), map[string]bool{d.Id(): true})
+}
+
 func ZoneRefreshFunc(zoneClient client.ZoneClientInterface, zoneName string) func() (result interface{}, state string, err error) {
 	return func() (result interface{}, state string, err error) {
 		zone, err := zoneClient.Get(zoneName)
@@ -0,0 +1,140 @@
+package sandwich
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/sandwichcloud/deli-cli/api"
+)
+
+// fakeZoneAPI serves a single zone and records the calls made against it
+type fakeZoneAPI struct {
+	lock  sync.Mutex
+	zone  api.Zone
+	calls []string
+}
+
+func (f *fakeZoneAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+
+	data, _ := ioutil.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodPatch && r.URL.Path == "/location/v1/zones/"+f.zone.Name:
+		json.Unmarshal(data, &f.zone)
+		json.NewEncoder(w).Encode(f.zone)
+	case r.Method == http.MethodPut && r.URL.Path == "/location/v1/zones/"+f.zone.Name+"/action/schedule":
+		json.Unmarshal(data, &f.zone)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/location/v1/zones/"+f.zone.Name:
+		json.NewEncoder(w).Encode(f.zone)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status": "Not Found"}`))
+	}
+}
+
+func TestResourceZoneUpdate(t *testing.T) {
+	cases := []struct {
+		name      string
+		old       map[string]string
+		raw       map[string]interface{}
+		wantPatch bool
+	}{
+		{
+			name:      "provision percents changed",
+			old:       map[string]string{"core_provision_percent": "1600", "ram_provision_percent": "150", "schedulable": "true"},
+			raw:       map[string]interface{}{"core_provision_percent": 800, "ram_provision_percent": 200, "schedulable": true},
+			wantPatch: true,
+		},
+		{
+			name: "only schedulable changed",
+			old:  map[string]string{"core_provision_percent": "1600", "ram_provision_percent": "150", "schedulable": "true"},
+			raw:  map[string]interface{}{"core_provision_percent": 1600, "ram_provision_percent": 150, "schedulable": false},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := &fakeZoneAPI{zone: api.Zone{Name: "zone-a", RegionName: "region-a", CoreProvisionPercent: 1600, RamProvisionPercent: 150, Schedulable: true}}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			config := &Config{SandwichClient: &sandwichClient{APIServer: &server.URL, HttpClient: server.Client()}}
+			state := applyZoneUpdate(t, config, tc.old, tc.raw)
+
+			patched := false
+			for _, call := range fake.calls {
+				if call == "PATCH /location/v1/zones/zone-a" {
+					patched = true
+				}
+			}
+			if patched != tc.wantPatch {
+				t.Fatalf("expected PATCH to be sent: %t, calls were %v", tc.wantPatch, fake.calls)
+			}
+
+			for _, k := range []string{"core_provision_percent", "ram_provision_percent"} {
+				if got, want := state.Attributes[k], fmt.Sprintf("%v", tc.raw[k]); got != want {
+					t.Fatalf("expected %s %s after update, got %s", k, want, got)
+				}
+			}
+			if fake.zone.Schedulable != tc.raw["schedulable"] {
+				t.Fatalf("expected schedulable %v, got %t", tc.raw["schedulable"], fake.zone.Schedulable)
+			}
+		})
+	}
+}
+
+// applyZoneUpdate plans and applies a change to an existing zone the way Terraform does
+func applyZoneUpdate(t *testing.T, meta interface{}, old map[string]string, raw map[string]interface{}) *terraform.InstanceState {
+	r := resourceZone()
+	// The workload check on unschedule is not what is being tested here
+	r.CustomizeDiff = nil
+
+	attributes := map[string]string{
+		"name":         "zone-a",
+		"region_name":  "region-a",
+		"vm_cluster":   "cluster",
+		"vm_datastore": "datastore",
+	}
+	for k, v := range old {
+		attributes[k] = v
+	}
+	state := &terraform.InstanceState{ID: "zone-a", Attributes: attributes}
+
+	values := map[string]interface{}{
+		"name":         "zone-a",
+		"region_name":  "region-a",
+		"vm_cluster":   "cluster",
+		"vm_datastore": "datastore",
+	}
+	for k, v := range raw {
+		values[k] = v
+	}
+	rawConfig, err := config.NewRawConfig(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected an in place update, got %#v", diff)
+	}
+
+	newState, err := r.Apply(state, diff, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newState
+}
@@ -2,8 +2,11 @@ package sandwich
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/sandwichcloud/deli-cli/api"
 )
 
 func getProject(d *schema.ResourceData, config *Config) (string, error) {
@@ -20,3 +23,48 @@ func getProjectFromSchema(projectSchemaField string, d *schema.ResourceData, con
 	}
 	return "", fmt.Errorf("%s: required field is not set", projectSchemaField)
 }
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
+
+func setToStrings(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+func stringInSlice(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotFoundError(err error) bool {
+	if apiError, ok := err.(api.APIErrorInterface); ok {
+		return apiError.IsNotFound()
+	}
+	return false
+}
+
+func waitForState(refresh resource.StateRefreshFunc, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
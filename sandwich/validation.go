@@ -0,0 +1,116 @@
+package sandwich
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The API only accepts lowercase DNS labels as object names
+var nameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+var iamMemberRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`^user:[^\s:]+$`),
+	regexp.MustCompile(`^serviceAccount:[^\s@:]+@[^\s@:]+$`),
+	regexp.MustCompile(`^group:[^\s:]+$`),
+}
+
+func validateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q must be at most 63 characters long, got %q", k, value))
+	}
+
+	if !nameRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a lowercase letter, only contain lowercase letters, numbers and hyphens, and not end with a hyphen, got %q", k, value))
+	}
+
+	return
+}
+
+func validateCIDR(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid CIDR, got %q: %s", k, value, err))
+		return
+	}
+
+	if ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q must be an IPv4 CIDR, got %q", k, value))
+		return
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		errors = append(errors, fmt.Errorf("%q must be a network address, got %q (did you mean %q?)", k, value, ipNet.String()))
+	}
+
+	return
+}
+
+func validateIPAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid IPv4 address, got %q", k, value))
+	}
+
+	return
+}
+
+func validateIAMMember(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	for _, memberRegex := range iamMemberRegexes {
+		if memberRegex.MatchString(value) {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be of the form user:{username}, serviceAccount:{email} or group:{name}, got %q", k, value))
+	return
+}
+
+// Provisioning below 100% would leave part of the zone unusable
+func validateProvisionPercent(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value < 100 {
+		errors = append(errors, fmt.Errorf("%q must be at least 100, got %d", k, value))
+	}
+	if value > 10000 {
+		errors = append(errors, fmt.Errorf("%q must be at most 10000, got %d", k, value))
+	}
+
+	return
+}
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 15m, got %q: %s", k, value, err))
+	}
+
+	return
+}
+
+func validateOneOf(values ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		for _, valid := range values {
+			if value == valid {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf("%q must be one of %s, got %q", k, strings.Join(values, ", "), value))
+		return
+	}
+}
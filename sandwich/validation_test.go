@@ -0,0 +1,66 @@
+package sandwich
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidators(t *testing.T) {
+	cases := []struct {
+		name     string
+		validate schema.SchemaValidateFunc
+		value    interface{}
+		wantErr  bool
+	}{
+		{name: "name", validate: validateName, value: "web-1"},
+		{name: "name single letter", validate: validateName, value: "a"},
+		{name: "name uppercase", validate: validateName, value: "Web-1", wantErr: true},
+		{name: "name leading digit", validate: validateName, value: "1web", wantErr: true},
+		{name: "name trailing hyphen", validate: validateName, value: "web-", wantErr: true},
+		{name: "name underscore", validate: validateName, value: "web_1", wantErr: true},
+		{name: "name empty", validate: validateName, value: "", wantErr: true},
+		{name: "name 63 characters", validate: validateName, value: "a" + strings.Repeat("b", 62)},
+		{name: "name 64 characters", validate: validateName, value: "a" + strings.Repeat("b", 63), wantErr: true},
+
+		{name: "cidr", validate: validateCIDR, value: "10.0.0.0/24"},
+		{name: "cidr host address", validate: validateCIDR, value: "10.0.0.1/24", wantErr: true},
+		{name: "cidr without prefix", validate: validateCIDR, value: "10.0.0.0", wantErr: true},
+		{name: "cidr ipv6", validate: validateCIDR, value: "fd00::/64", wantErr: true},
+
+		{name: "ip address", validate: validateIPAddress, value: "10.0.0.1"},
+		{name: "ip address ipv6", validate: validateIPAddress, value: "fd00::1", wantErr: true},
+		{name: "ip address hostname", validate: validateIPAddress, value: "gateway", wantErr: true},
+
+		{name: "iam user", validate: validateIAMMember, value: "user:alice"},
+		{name: "iam service account", validate: validateIAMMember, value: "serviceAccount:ci@project-a"},
+		{name: "iam group", validate: validateIAMMember, value: "group:admins"},
+		{name: "iam service account without project", validate: validateIAMMember, value: "serviceAccount:ci", wantErr: true},
+		{name: "iam unknown kind", validate: validateIAMMember, value: "robot:ci", wantErr: true},
+		{name: "iam bare name", validate: validateIAMMember, value: "alice", wantErr: true},
+
+		{name: "provision percent", validate: validateProvisionPercent, value: 1600},
+		{name: "provision percent lower bound", validate: validateProvisionPercent, value: 100},
+		{name: "provision percent too low", validate: validateProvisionPercent, value: 99, wantErr: true},
+		{name: "provision percent too high", validate: validateProvisionPercent, value: 10001, wantErr: true},
+
+		{name: "duration", validate: validateDuration, value: "15m"},
+		{name: "duration without unit", validate: validateDuration, value: "15", wantErr: true},
+
+		{name: "one of", validate: validateOneOf("restore", "clear"), value: "clear"},
+		{name: "one of unknown value", validate: validateOneOf("restore", "clear"), value: "keep", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errors := tc.validate(tc.value, "field")
+			if tc.wantErr && len(errors) == 0 {
+				t.Fatalf("expected %v to be rejected", tc.value)
+			}
+			if !tc.wantErr && len(errors) > 0 {
+				t.Fatalf("expected %v to be accepted, got %v", tc.value, errors)
+			}
+		})
+	}
+}